@@ -0,0 +1,182 @@
+// Package jsonattr gives string attributes holding JSON documents (workflow configurations, worker attributes, Studio
+// flow definitions, ...) consistent validation, normalization and diffing.
+package jsonattr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Attribute sets up a TypeString schema to hold a JSON document: values are validated with ValidateFunc, stored in
+// canonical form with StateFunc, and documents that only differ in formatting or key order don't show as diffs. Funcs
+// the schema already sets are kept.
+func Attribute(s *schema.Schema) *schema.Schema {
+	if s.ValidateFunc == nil {
+		s.ValidateFunc = ValidateFunc
+	}
+	if s.DiffSuppressFunc == nil {
+		s.DiffSuppressFunc = DiffSuppressFunc
+	}
+	if s.StateFunc == nil {
+		s.StateFunc = StateFunc
+	}
+	return s
+}
+
+// Normalize returns the canonical form of a JSON document: compact, with object keys sorted. Numbers are kept as
+// written, so large integers don't lose precision.
+func Normalize(value string) (string, error) {
+	document, err := decode(value)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimRight(buf.Bytes(), "\n")), nil
+}
+
+// Equivalent reports whether two JSON documents are semantically equal. Invalid documents are never equivalent.
+func Equivalent(a, b string) bool {
+	documentA, err := decode(a)
+	if err != nil {
+		return false
+	}
+
+	documentB, err := decode(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(documentA, documentB)
+}
+
+// DiffSuppressFunc is a schema.SchemaDiffSuppressFunc suppressing diffs between semantically equal JSON documents.
+func DiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return Equivalent(old, new)
+}
+
+// StateFunc is a schema.SchemaStateFunc storing JSON documents in canonical form. Invalid documents are stored as
+// they are, so that ValidateFunc can report them.
+func StateFunc(v interface{}) string {
+	value, ok := v.(string)
+	if !ok {
+		return ""
+	}
+
+	normalized, err := Normalize(value)
+	if err != nil {
+		return value
+	}
+	return normalized
+}
+
+// ValidateFunc is a schema.SchemaValidateFunc checking that a value is a JSON document, reporting where it is
+// malformed.
+func ValidateFunc(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := decode(value); err != nil {
+		return nil, []error{fmt.Errorf("%q contains an invalid JSON document: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// Document is a JSON document kept as its text, for API fields that hold JSON values rather than strings (e.g. a Sync
+// document's data). It maps to a string attribute, and an empty Document is encoded as null.
+type Document string
+
+// MarshalJSON implements json.Marshaler.
+func (d Document) MarshalJSON() ([]byte, error) {
+	if d == "" {
+		return []byte("null"), nil
+	}
+	if !json.Valid([]byte(d)) {
+		return nil, fmt.Errorf("invalid JSON document %q", string(d))
+	}
+	return []byte(d), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Document) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = ""
+		return nil
+	}
+	*d = Document(data)
+	return nil
+}
+
+// SyntaxError is a JSON syntax error, located by line and column.
+type SyntaxError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Err)
+}
+
+// decode parses a single JSON document, keeping numbers as json.Number.
+func decode(value string) (interface{}, error) {
+	// json.Unmarshal reports where the document is malformed, including trailing data after it.
+	if err := json.Unmarshal([]byte(value), new(json.RawMessage)); err != nil {
+		return nil, locate(value, err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, locate(value, err)
+	}
+	return document, nil
+}
+
+// locate wraps a decoding error with the line and column it occurred at, when it is known.
+func locate(value string, err error) error {
+	var offset int64
+	switch err := err.(type) {
+	case *json.SyntaxError:
+		offset = err.Offset
+	case *json.UnmarshalTypeError:
+		offset = err.Offset
+	default:
+		return err
+	}
+
+	// The offset is just past the offending byte.
+	if offset > 0 {
+		offset--
+	}
+	if offset > int64(len(value)) {
+		offset = int64(len(value))
+	}
+
+	line, column := 1, 1
+	for _, c := range value[:offset] {
+		if c == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	return &SyntaxError{Line: line, Column: column, Err: err}
+}
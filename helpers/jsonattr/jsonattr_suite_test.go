@@ -0,0 +1,13 @@
+package jsonattr_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestJSONAttr(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "JSON Attribute Suite")
+}
@@ -0,0 +1,143 @@
+package jsonattr_test
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+)
+
+const workflowConfiguration = `{
+  "task_routing": {
+    "filters": [
+      {
+        "filter_friendly_name": "Sales",
+        "expression": "type == \"sales\"",
+        "targets": [{"queue": "WQ0123456789abcdef0123456789abcdef", "timeout": 300}]
+      }
+    ],
+    "default_filter": {"queue": "WQ0123456789abcdef0123456789abcdef"}
+  }
+}`
+
+var _ = Describe("JSON attributes", func() {
+
+	Describe("Normalize", func() {
+
+		It("should compact the document and sort its keys", func() {
+			normalized, err := jsonattr.Normalize(`{ "b": [1, 2],
+				"a": {"d": true, "c": null} }`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(normalized).To(Equal(`{"a":{"c":null,"d":true},"b":[1,2]}`))
+		})
+
+		It("should keep numbers and HTML characters as written", func() {
+			normalized, err := jsonattr.Normalize(`{"sid": 12345678901234567890, "expression": "a < b && c > d"}`)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(normalized).To(Equal(`{"expression":"a < b && c > d","sid":12345678901234567890}`))
+		})
+
+		It("should error on invalid documents", func() {
+			_, err := jsonattr.Normalize(`{"a": }`)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("DiffSuppressFunc", func() {
+
+		It("should suppress diffs that only change formatting or key order", func() {
+			normalized, _ := jsonattr.Normalize(workflowConfiguration)
+			Expect(jsonattr.DiffSuppressFunc("configuration", normalized, workflowConfiguration, nil)).To(Equal(true))
+			Expect(jsonattr.DiffSuppressFunc("attributes", `{"a":1,"b":2}`, `{"b": 2, "a": 1}`, nil)).To(Equal(true))
+		})
+
+		It("should not suppress real changes", func() {
+			Expect(jsonattr.DiffSuppressFunc("attributes", `{"a":1}`, `{"a":2}`, nil)).To(Equal(false))
+			Expect(jsonattr.DiffSuppressFunc("attributes", `[1,2]`, `[2,1]`, nil)).To(Equal(false))
+			Expect(jsonattr.DiffSuppressFunc("attributes", `{"a":1}`, ``, nil)).To(Equal(false))
+		})
+	})
+
+	Describe("StateFunc", func() {
+
+		It("should store the canonical form", func() {
+			Expect(jsonattr.StateFunc(`{ "b": 1, "a": 2 }`)).To(Equal(`{"a":2,"b":1}`))
+		})
+
+		It("should store invalid documents as they are", func() {
+			Expect(jsonattr.StateFunc(`{"a": `)).To(Equal(`{"a": `))
+		})
+	})
+
+	Describe("ValidateFunc", func() {
+
+		It("should accept JSON documents", func() {
+			_, errs := jsonattr.ValidateFunc(workflowConfiguration, "configuration")
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should report the line and column of syntax errors", func() {
+			_, errs := jsonattr.ValidateFunc("{\n  \"a\": 1,\n  \"b\" 2\n}", "attributes")
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Error()).To(ContainSubstring("line 3, column 7"))
+		})
+
+		It("should reject trailing data and empty values", func() {
+			_, errs := jsonattr.ValidateFunc(`{"a": 1} {"b": 2}`, "attributes")
+			Expect(errs).To(HaveLen(1))
+
+			_, errs = jsonattr.ValidateFunc(``, "attributes")
+			Expect(errs).To(HaveLen(1))
+		})
+	})
+
+	Describe("Document", func() {
+
+		It("should keep a JSON value as its text", func() {
+			var item struct {
+				Data jsonattr.Document `json:"data"`
+			}
+			Expect(json.Unmarshal([]byte(`{"data": {"theme": "dark", "beta": [1, 2]}}`), &item)).ShouldNot(HaveOccurred())
+			Expect(string(item.Data)).To(Equal(`{"theme": "dark", "beta": [1, 2]}`))
+
+			encoded, err := json.Marshal(item)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(encoded)).To(Equal(`{"data":{"theme":"dark","beta":[1,2]}}`))
+		})
+
+		It("should treat null as an empty document", func() {
+			var item struct {
+				Data jsonattr.Document `json:"data"`
+			}
+			Expect(json.Unmarshal([]byte(`{"data": null}`), &item)).ShouldNot(HaveOccurred())
+			Expect(item.Data).To(BeEmpty())
+
+			encoded, err := json.Marshal(item)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(encoded)).To(Equal(`{"data":null}`))
+		})
+	})
+
+	Describe("Attribute", func() {
+
+		It("should set the JSON funcs, keeping those already set", func() {
+			validate := func(v interface{}, k string) ([]string, []error) { return nil, nil }
+			s := jsonattr.Attribute(&schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate,
+			})
+
+			Expect(s.DiffSuppressFunc).ShouldNot(BeNil())
+			Expect(s.StateFunc).ShouldNot(BeNil())
+			_, errs := s.ValidateFunc("not json", "attributes")
+			Expect(errs).To(BeEmpty())
+		})
+	})
+})
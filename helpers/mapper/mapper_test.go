@@ -1,10 +1,17 @@
 package mapper_test
 
 import (
+	"net/url"
+	"regexp"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/hashicorp/terraform/terraform"
 	. "github.com/onsi/ginkgo"
 
-	"github.com/Preskton/terraform-provider-twilio/helpers/mapper"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twilio "github.com/kaiquelupo/twilio-go"
 	. "github.com/onsi/gomega"
 )
 
@@ -18,9 +25,9 @@ type WeaponStats struct {
 
 type Weapon struct {
 	WeaponID           string      `terraform:"id"`
-	Name               string      `terraform:"name"`
-	Manufacturer       string      `terraform:"manufacturer_name"`
-	Stats              WeaponStats `terraform:"stats"`
+	Name               string      `terraform:"name,required"`
+	Manufacturer       string      `terraform:"manufacturer_name,required"`
+	Stats              WeaponStats `terraform:"stats,set,maxitems=1"`
 	PowerUpCosts       []int       `terraform:"power_up_costs"`
 	SomethingWithNoTag int         `notthetagyourelookingfor:"lol"`
 }
@@ -84,7 +91,7 @@ func resourceTestWidget() *schema.Resource {
 	}
 }
 
-var _ = Describe("Preskton's Mappers", func() {
+var _ = Describe("Mappers", func() {
 	var (
 		weapons = map[string]*Weapon{
 			"tkSplatRoller": &Weapon{
@@ -203,3 +210,845 @@ var _ = Describe("Preskton's Mappers", func() {
 		})
 	})
 })
+
+type WeaponOrder struct {
+	Name         string  `terraform:"name" form:"Name,omitempty"`
+	Manufacturer *string `terraform:"manufacturer_name" form:"ManufacturerName"`
+	Quantity     *int    `terraform:"quantity" form:"Quantity"`
+	GiftWrapped  *bool   `terraform:"gift_wrapped" form:"GiftWrapped"`
+	PowerUpCosts []int   `terraform:"power_up_costs" form:"PowerUpCost,omitempty"`
+	Notes        string  `terraform:"notes" form:"Notes,omitempty"`
+}
+
+type WeaponDelivery struct {
+	Express bool    `terraform:"express" form:"Express"`
+	Address *string `terraform:"address" form:"Address"`
+	Tracked *bool   `terraform:"tracked" form:"Tracked"`
+}
+
+type WeaponShipment struct {
+	Name     string          `terraform:"name,required" form:"Name,omitempty"`
+	Delivery *WeaponDelivery `terraform:"delivery" form:"Delivery"`
+}
+
+func weaponOrderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"manufacturer_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"quantity": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"gift_wrapped": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"power_up_costs": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+		"notes": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+// planResourceData returns the ResourceData Terraform hands to Create (no state) or Update for the given config.
+func planResourceData(sm map[string]*schema.Schema, state *terraform.InstanceState, config map[string]interface{}) *schema.ResourceData {
+	internalMap := schema.InternalMap(sm)
+
+	diff, err := internalMap.Diff(state, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	d, err := internalMap.Data(state, diff)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return d
+}
+
+var _ = Describe("Unmarshalers", func() {
+	var (
+		order  *WeaponOrder
+		values url.Values
+		err    error
+	)
+
+	Describe("Terraform Unmarshal", func() {
+
+		Context("When it reads a new resource into a struct", func() {
+
+			BeforeEach(func() {
+				d := planResourceData(weaponOrderSchema(), nil, map[string]interface{}{
+					"name":           "Kensa Splat Roller",
+					"gift_wrapped":   true,
+					"power_up_costs": []interface{}{5, 10},
+				})
+
+				order = &WeaponOrder{}
+				err = mapper.UnmarshalFromTerraform(d, order)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should copy the attributes that are set", func() {
+				Expect(order.Name).To(Equal("Kensa Splat Roller"))
+				Expect(order.GiftWrapped).ShouldNot(BeNil())
+				Expect(*order.GiftWrapped).To(Equal(true))
+				Expect(order.PowerUpCosts).To(Equal([]int{5, 10}))
+			})
+
+			It("should leave the attributes that aren't set alone", func() {
+				Expect(order.Manufacturer).To(BeNil())
+				Expect(order.Quantity).To(BeNil())
+				Expect(order.Notes).To(Equal(""))
+			})
+		})
+
+		Context("When a new resource sets attributes to their zero value", func() {
+
+			BeforeEach(func() {
+				d := planResourceData(weaponOrderSchema(), nil, map[string]interface{}{
+					"name":         "Kensa Splat Roller",
+					"gift_wrapped": false,
+					"quantity":     0,
+				})
+
+				order = &WeaponOrder{}
+				err = mapper.UnmarshalFromTerraform(d, order)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				values, err = mapper.MarshalToURLValues(order)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should send the zero values", func() {
+				Expect(values.Get("GiftWrapped")).To(Equal("false"))
+				Expect(values.Get("Quantity")).To(Equal("0"))
+			})
+
+			It("should leave the attributes that aren't set alone", func() {
+				Expect(values).NotTo(HaveKey("ManufacturerName"))
+			})
+		})
+
+		Context("When it reads an updated resource into a struct", func() {
+
+			BeforeEach(func() {
+				state := &terraform.InstanceState{
+					ID: "TK1337",
+					Attributes: map[string]string{
+						"id":                "TK1337",
+						"name":              "Kensa Splat Roller",
+						"manufacturer_name": "Toni Kensa",
+						"quantity":          "2",
+						"notes":             "groovy",
+						"power_up_costs.#":  "0",
+					},
+				}
+				d := planResourceData(weaponOrderSchema(), state, map[string]interface{}{
+					"name":     "Kensa Splat Roller",
+					"quantity": 3,
+					"notes":    "groovy",
+				})
+
+				order = &WeaponOrder{}
+				err = mapper.UnmarshalFromTerraform(d, order)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should copy the attributes that changed", func() {
+				Expect(order.Quantity).ShouldNot(BeNil())
+				Expect(*order.Quantity).To(Equal(3))
+			})
+
+			It("should copy cleared attributes as pointers to the zero value", func() {
+				Expect(order.Manufacturer).ShouldNot(BeNil())
+				Expect(*order.Manufacturer).To(Equal(""))
+			})
+
+			It("should leave the attributes that didn't change alone", func() {
+				Expect(order.Name).To(Equal(""))
+				Expect(order.Notes).To(Equal(""))
+				Expect(order.GiftWrapped).To(BeNil())
+			})
+		})
+
+		Context("When it reads a nested block into a struct", func() {
+			var shipment *WeaponShipment
+
+			BeforeEach(func() {
+				sm, err := mapper.SchemaFromStruct(&WeaponShipment{}, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				d := planResourceData(sm, nil, map[string]interface{}{
+					"name": "Kensa Splat Roller",
+					"delivery": []interface{}{
+						map[string]interface{}{
+							"address": "Inkopolis Square",
+						},
+					},
+				})
+
+				shipment = &WeaponShipment{}
+				err = mapper.UnmarshalFromTerraform(d, shipment)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should copy the block's attributes", func() {
+				Expect(shipment.Delivery).ShouldNot(BeNil())
+				Expect(shipment.Delivery.Express).To(Equal(false))
+				Expect(shipment.Delivery.Address).ShouldNot(BeNil())
+				Expect(*shipment.Delivery.Address).To(Equal("Inkopolis Square"))
+			})
+
+			It("should leave the block's pointer fields that aren't set nil", func() {
+				Expect(shipment.Delivery.Tracked).To(BeNil())
+			})
+		})
+
+		Context("When a nested block is left out", func() {
+
+			It("should leave the struct pointer nil", func() {
+				sm, err := mapper.SchemaFromStruct(&WeaponShipment{}, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				d := planResourceData(sm, nil, map[string]interface{}{
+					"name": "Kensa Splat Roller",
+				})
+
+				shipment := &WeaponShipment{}
+				Expect(mapper.UnmarshalFromTerraform(d, shipment)).ShouldNot(HaveOccurred())
+				Expect(shipment.Delivery).To(BeNil())
+			})
+		})
+
+		Context("When it is given something other than a pointer to a struct", func() {
+
+			It("should error", func() {
+				d := planResourceData(weaponOrderSchema(), nil, map[string]interface{}{
+					"name": "Kensa Splat Roller",
+				})
+
+				Expect(mapper.UnmarshalFromTerraform(d, WeaponOrder{})).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("URL Values Marshal", func() {
+
+		Context("When it encodes a struct as form parameters", func() {
+
+			BeforeEach(func() {
+				manufacturer := ""
+				wrapped := false
+				order = &WeaponOrder{
+					Name:         "Kensa Splat Roller",
+					Manufacturer: &manufacturer,
+					GiftWrapped:  &wrapped,
+					PowerUpCosts: []int{5, 10, 15},
+				}
+				values, err = mapper.MarshalToURLValues(order)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should encode values per the `form` tags", func() {
+				Expect(values.Get("Name")).To(Equal("Kensa Splat Roller"))
+				Expect(values["GiftWrapped"]).To(Equal([]string{"false"}))
+			})
+
+			It("should send pointers to zero values, so that attributes can be cleared", func() {
+				Expect(values["ManufacturerName"]).To(Equal([]string{""}))
+			})
+
+			It("should leave out nil pointers and empty `omitempty` fields", func() {
+				_, ok := values["Quantity"]
+				Expect(ok).To(Equal(false))
+
+				_, ok = values["Notes"]
+				Expect(ok).To(Equal(false))
+			})
+
+			It("should repeat the parameter for list fields", func() {
+				Expect(values["PowerUpCost"]).To(Equal([]string{"5", "10", "15"}))
+			})
+		})
+
+		Context("When it encodes a nested struct", func() {
+
+			It("should prefix the nested parameters with the struct's name", func() {
+				address := "Inkopolis Square"
+				values, err := mapper.MarshalToURLValues(&WeaponShipment{
+					Name: "Kensa Splat Roller",
+					Delivery: &WeaponDelivery{
+						Express: true,
+						Address: &address,
+					},
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(values).To(Equal(url.Values{
+					"Name":             []string{"Kensa Splat Roller"},
+					"Delivery.Express": []string{"true"},
+					"Delivery.Address": []string{"Inkopolis Square"},
+				}))
+			})
+		})
+
+		Context("When it is given something other than a struct", func() {
+
+			It("should error", func() {
+				_, err := mapper.MarshalToURLValues(nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
+
+type SubWeapon struct {
+	Name    string `terraform:"name"`
+	InkCost int    `terraform:"ink_cost"`
+}
+
+type WeaponKit struct {
+	KitID          string             `terraform:"id"`
+	Owner          *string            `terraform:"owner"`
+	Nickname       *string            `terraform:"nickname"`
+	Subs           []SubWeapon        `terraform:"subs"`
+	Specials       []*SubWeapon       `terraform:"specials"`
+	Labels         map[string]string  `terraform:"labels"`
+	Hotline        twilio.PhoneNumber `terraform:"hotline"`
+	ReleasedAt     time.Time          `terraform:"released_at"`
+	RestockedAt    twilio.TwilioTime  `terraform:"restocked_at"`
+	DiscontinuedAt twilio.TwilioTime  `terraform:"discontinued_at"`
+	Ratings        map[string]float64 `terraform:"ratings"`
+	Matches        *int64             `terraform:"matches"`
+}
+
+type UnknownWeapon struct {
+	Name         string `terraform:"name"`
+	SecretRecipe string `terraform:"secret_recipe"`
+}
+
+func subWeaponResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ink_cost": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTestWeaponKit() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"owner": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nickname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     subWeaponResource(),
+			},
+			"specials": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     subWeaponResource(),
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hotline": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"released_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"restocked_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"discontinued_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ratings": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeFloat,
+				},
+			},
+			"matches": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var _ = Describe("Nested Mappers", func() {
+	var (
+		owner    = "Agent 3"
+		matches  = int64(1337)
+		released = time.Date(2018, time.July, 13, 9, 30, 0, 0, time.UTC)
+		kit      = &WeaponKit{
+			KitID: "KIT-8",
+			Owner: &owner,
+			Subs: []SubWeapon{
+				{Name: "Splat Bomb", InkCost: 70},
+				{Name: "Sprinkler", InkCost: 60},
+			},
+			Specials: []*SubWeapon{
+				{Name: "Ink Armor", InkCost: 0},
+			},
+			Labels:         map[string]string{"brand": "Toni Kensa", "tier": "S"},
+			Hotline:        twilio.PhoneNumber("+14155551234"),
+			ReleasedAt:     released,
+			RestockedAt:    twilio.TwilioTime{Time: released.Add(24 * time.Hour), Valid: true},
+			DiscontinuedAt: twilio.TwilioTime{},
+			Ratings:        map[string]float64{"power": 4.5},
+			Matches:        &matches,
+		}
+		tfdata *schema.ResourceData
+		err    error
+	)
+
+	Describe("Terraform Marshal", func() {
+
+		Context("When it serializes nested and custom-typed fields", func() {
+
+			BeforeEach(func() {
+				tfdata = resourceTestWeaponKit().TestResourceData()
+				err = mapper.MarshalToTerraform(kit, tfdata, resourceTestWeaponKit().Schema)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tfdata.Id()).To(Equal("KIT-8"))
+			})
+
+			It("should follow pointers, and leave nil ones empty", func() {
+				Expect(tfdata.Get("owner")).To(Equal(owner))
+				Expect(tfdata.Get("nickname")).To(Equal(""))
+				Expect(tfdata.Get("matches")).To(Equal(1337))
+			})
+
+			It("should convert slices of structs into lists of nested blocks", func() {
+				subs := tfdata.Get("subs").([]interface{})
+				Expect(len(subs)).To(Equal(2))
+				Expect(subs[0].(map[string]interface{})["name"]).To(Equal("Splat Bomb"))
+				Expect(subs[1].(map[string]interface{})["ink_cost"]).To(Equal(60))
+			})
+
+			It("should convert slices of struct pointers into sets of nested blocks", func() {
+				specials := tfdata.Get("specials").(*schema.Set)
+				Expect(specials.Len()).To(Equal(1))
+				Expect(specials.List()[0].(map[string]interface{})["name"]).To(Equal("Ink Armor"))
+			})
+
+			It("should convert maps", func() {
+				Expect(tfdata.Get("labels")).To(Equal(map[string]interface{}{"brand": "Toni Kensa", "tier": "S"}))
+				Expect(tfdata.Get("ratings")).To(Equal(map[string]interface{}{"power": 4.5}))
+			})
+
+			It("should convert custom string types", func() {
+				Expect(tfdata.Get("hotline")).To(Equal("+14155551234"))
+			})
+
+			It("should write times as RFC 3339, and invalid TwilioTimes as empty strings", func() {
+				Expect(tfdata.Get("released_at")).To(Equal("2018-07-13T09:30:00Z"))
+				Expect(tfdata.Get("restocked_at")).To(Equal("2018-07-14T09:30:00Z"))
+				Expect(tfdata.Get("discontinued_at")).To(Equal(""))
+			})
+		})
+
+		Context("When a tagged field isn't in the schema", func() {
+
+			It("should error rather than panic", func() {
+				unknown := &UnknownWeapon{Name: "Kensa Splat Roller", SecretRecipe: "squid ink"}
+				tfdata = resourceTestWidget().TestResourceData()
+
+				var err error
+				Expect(func() {
+					err = mapper.MarshalToTerraform(unknown, tfdata, resourceTestWidget().Schema)
+				}).ShouldNot(Panic())
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("secret_recipe"))
+			})
+		})
+
+		Context("When a value doesn't fit its schema type", func() {
+
+			It("should error", func() {
+				wrong := &struct {
+					Name []string `terraform:"name"`
+				}{Name: []string{"Kensa", "Splat", "Roller"}}
+				tfdata = resourceTestWidget().TestResourceData()
+
+				Expect(mapper.MarshalToTerraform(wrong, tfdata, resourceTestWidget().Schema)).Should(HaveOccurred())
+			})
+		})
+	})
+})
+
+var _ = Describe("Hashcodes", func() {
+
+	Describe("Simple Hashcode", func() {
+
+		Context("When it hashes maps", func() {
+
+			It("should hash equal maps the same every time", func() {
+				first := map[string]interface{}{
+					"name":     "Kensa Splat Roller",
+					"power":    100,
+					"is_op":    true,
+					"range":    5.5,
+					"costs":    []interface{}{5, 10, 15},
+					"nickname": nil,
+				}
+
+				expected := mapper.SimpleHashcode(first)
+				for i := 0; i < 50; i++ {
+					second := map[string]interface{}{}
+					for key, value := range first {
+						second[key] = value
+					}
+					Expect(mapper.SimpleHashcode(second)).To(Equal(expected))
+				}
+			})
+
+			It("should hash different maps differently", func() {
+				Expect(mapper.SimpleHashcode(map[string]interface{}{"a": "b", "c": "d"})).
+					ShouldNot(Equal(mapper.SimpleHashcode(map[string]interface{}{"a": "d", "c": "b"})))
+				Expect(mapper.SimpleHashcode(map[string]interface{}{"power": 1})).
+					ShouldNot(Equal(mapper.SimpleHashcode(map[string]interface{}{"power": "1"})))
+			})
+		})
+
+		Context("When it hashes structs", func() {
+
+			It("should not panic on fields that aren't strings", func() {
+				stats := WeaponStats{Power: 100, Range: 5, RateOfFire: 35, Adjective: "groovy", IsOP: true}
+
+				Expect(func() { mapper.SimpleHashcode(stats) }).ShouldNot(Panic())
+				Expect(mapper.SimpleHashcode(stats)).To(Equal(mapper.SimpleHashcode(stats)))
+			})
+
+			It("should walk nested structs, slices and pointers", func() {
+				first := &WeaponKit{Subs: []SubWeapon{{Name: "Splat Bomb", InkCost: 70}}}
+				second := &WeaponKit{Subs: []SubWeapon{{Name: "Splat Bomb", InkCost: 60}}}
+
+				Expect(mapper.SimpleHashcode(first)).To(Equal(mapper.SimpleHashcode(&WeaponKit{Subs: []SubWeapon{{Name: "Splat Bomb", InkCost: 70}}})))
+				Expect(mapper.SimpleHashcode(first)).ShouldNot(Equal(mapper.SimpleHashcode(second)))
+			})
+		})
+	})
+
+	Describe("Hashcode By Keys", func() {
+
+		Context("When it hashes set items", func() {
+			hash := mapper.HashcodeByKeys("cidr")
+
+			It("should only hash the key fields", func() {
+				Expect(hash(map[string]interface{}{"cidr": "10.0.0.0/8", "friendly_name": "office"})).
+					To(Equal(hash(map[string]interface{}{"cidr": "10.0.0.0/8", "friendly_name": "home"})))
+				Expect(hash(map[string]interface{}{"cidr": "10.0.0.0/8", "friendly_name": "office"})).
+					ShouldNot(Equal(hash(map[string]interface{}{"cidr": "192.168.0.0/16", "friendly_name": "office"})))
+			})
+
+			It("should keep items with different keys apart in a set", func() {
+				set := schema.NewSet(hash, []interface{}{
+					map[string]interface{}{"cidr": "10.0.0.0/8", "friendly_name": "office"},
+					map[string]interface{}{"cidr": "192.168.0.0/16", "friendly_name": "office"},
+					map[string]interface{}{"cidr": "10.0.0.0/8", "friendly_name": "home"},
+				})
+
+				Expect(set.Len()).To(Equal(2))
+			})
+		})
+	})
+})
+
+type WeaponListing struct {
+	ListingID   string             `terraform:"id"`
+	Name        string             `terraform:"name,required,forcenew"`
+	Shop        string             `terraform:"shop,default=Ammo Knights"`
+	Currency    string             `terraform:"currency,oneof=cash|sheldon_licenses"`
+	Price       int                `terraform:"price,min=0,max=99999"`
+	Discount    float64            `terraform:"discount,default=0.25"`
+	OnSale      bool               `terraform:"on_sale,default=true"`
+	SellerCode  string             `terraform:"seller_code,sensitive"`
+	Description string             `terraform:"description,validate=json"`
+	Hotline     twilio.PhoneNumber `terraform:"hotline,validate=phone"`
+	Rank        *int               `terraform:"rank,optional,computed"`
+	ListedAt    twilio.TwilioTime  `terraform:"listed_at,computed"`
+	Tags        []string           `terraform:"tags,set"`
+	Labels      map[string]string  `terraform:"labels"`
+	Stats       *WeaponStats       `terraform:"stats,computed"`
+	Subs        []SubWeapon        `terraform:"subs,minitems=1,maxitems=3"`
+	notExported string             `terraform:"not_exported"`
+	Notes       string
+}
+
+var _ = Describe("Schema Generators", func() {
+	var (
+		sm  map[string]*schema.Schema
+		err error
+	)
+
+	Describe("Schema From Struct", func() {
+
+		Context("When it derives the schema of the test widget", func() {
+
+			BeforeEach(func() {
+				sm, err = mapper.SchemaFromStruct(Weapon{}, nil)
+			})
+
+			It("should not error", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should produce a valid schema", func() {
+				Expect(schema.InternalMap(sm).InternalValidate(nil)).ShouldNot(HaveOccurred())
+			})
+
+			It("should match the hand-written widget schema", func() {
+				expected := resourceTestWidget().Schema
+
+				Expect(len(sm)).To(Equal(len(expected)))
+				for name, expectedSchema := range expected {
+					Expect(sm).To(HaveKey(name))
+					Expect(sm[name].Type).To(Equal(expectedSchema.Type), name)
+					Expect(sm[name].Required).To(Equal(expectedSchema.Required), name)
+					Expect(sm[name].MaxItems).To(Equal(expectedSchema.MaxItems), name)
+				}
+
+				expectedStats := expected["stats"].Elem.(*schema.Resource).Schema
+				stats := sm["stats"].Elem.(*schema.Resource).Schema
+				Expect(len(stats)).To(Equal(len(expectedStats)))
+				for name, expectedSchema := range expectedStats {
+					Expect(stats).To(HaveKey(name))
+					Expect(stats[name].Type).To(Equal(expectedSchema.Type), name)
+					Expect(stats[name].Optional).To(Equal(expectedSchema.Optional), name)
+				}
+
+				Expect(sm["power_up_costs"].Elem.(*schema.Schema).Type).To(Equal(schema.TypeInt))
+			})
+
+			It("should work with MarshalToTerraform", func() {
+				tfdata := planResourceData(sm, nil, map[string]interface{}{
+					"name":              "Splattershot",
+					"manufacturer_name": "Zink",
+				})
+				weapon := &Weapon{
+					WeaponID:     "TK1337",
+					Name:         "Kensa Splat Roller",
+					Manufacturer: "Toni Kensa",
+					Stats:        WeaponStats{Power: 100, Adjective: "groovy"},
+					PowerUpCosts: []int{5, 10},
+				}
+
+				Expect(mapper.MarshalToTerraform(weapon, tfdata, sm)).ShouldNot(HaveOccurred())
+				Expect(tfdata.Get("name")).To(Equal("Kensa Splat Roller"))
+				Expect(tfdata.Get("stats").(*schema.Set).Len()).To(Equal(1))
+			})
+		})
+
+		Context("When it derives a schema from tag options", func() {
+
+			BeforeEach(func() {
+				sm, err = mapper.SchemaFromStruct(&WeaponListing{}, &mapper.SchemaOptions{
+					Validators: map[string]schema.SchemaValidateFunc{
+						"phone": validation.StringMatch(regexp.MustCompile(`^\+\d+$`), "must be E.164"),
+					},
+				})
+			})
+
+			It("should not error and produce a valid schema", func() {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(schema.InternalMap(sm).InternalValidate(nil)).ShouldNot(HaveOccurred())
+			})
+
+			It("should skip the ID, unexported and untagged fields", func() {
+				Expect(sm).ShouldNot(HaveKey("id"))
+				Expect(sm).ShouldNot(HaveKey("not_exported"))
+				Expect(len(sm)).To(Equal(15))
+			})
+
+			It("should set the schema flags", func() {
+				Expect(sm["name"].Required).To(Equal(true))
+				Expect(sm["name"].ForceNew).To(Equal(true))
+				Expect(sm["shop"].Optional).To(Equal(true))
+				Expect(sm["seller_code"].Sensitive).To(Equal(true))
+				Expect(sm["rank"].Optional).To(Equal(true))
+				Expect(sm["rank"].Computed).To(Equal(true))
+				Expect(sm["listed_at"].Optional).To(Equal(false))
+				Expect(sm["listed_at"].Computed).To(Equal(true))
+			})
+
+			It("should parse defaults according to the attribute type", func() {
+				Expect(sm["shop"].Default).To(Equal("Ammo Knights"))
+				Expect(sm["discount"].Default).To(Equal(0.25))
+				Expect(sm["on_sale"].Default).To(Equal(true))
+			})
+
+			It("should derive types from the field types", func() {
+				Expect(sm["hotline"].Type).To(Equal(schema.TypeString))
+				Expect(sm["listed_at"].Type).To(Equal(schema.TypeString))
+				Expect(sm["rank"].Type).To(Equal(schema.TypeInt))
+				Expect(sm["tags"].Type).To(Equal(schema.TypeSet))
+				Expect(sm["labels"].Type).To(Equal(schema.TypeMap))
+				Expect(sm["stats"].Type).To(Equal(schema.TypeList))
+				Expect(sm["stats"].MaxItems).To(Equal(1))
+				Expect(sm["subs"].MinItems).To(Equal(1))
+				Expect(sm["subs"].MaxItems).To(Equal(3))
+			})
+
+			It("should make the attributes of read-only blocks read-only", func() {
+				stats := sm["stats"].Elem.(*schema.Resource).Schema
+				Expect(stats["power_value"].Computed).To(Equal(true))
+				Expect(stats["power_value"].Optional).To(Equal(false))
+			})
+
+			It("should add the validation hints", func() {
+				_, errs := sm["currency"].ValidateFunc("sheldon_licenses", "currency")
+				Expect(errs).To(BeEmpty())
+				_, errs = sm["currency"].ValidateFunc("sea_snails", "currency")
+				Expect(errs).ShouldNot(BeEmpty())
+
+				_, errs = sm["price"].ValidateFunc(100000, "price")
+				Expect(errs).ShouldNot(BeEmpty())
+
+				_, errs = sm["description"].ValidateFunc("{not json", "description")
+				Expect(errs).ShouldNot(BeEmpty())
+
+				_, errs = sm["hotline"].ValidateFunc("+14155551234", "hotline")
+				Expect(errs).To(BeEmpty())
+				_, errs = sm["hotline"].ValidateFunc("(415) 555-1234", "hotline")
+				Expect(errs).ShouldNot(BeEmpty())
+			})
+		})
+
+		Context("When a field holds a JSON document", func() {
+
+			It("should set the attribute up with the JSON helpers", func() {
+				sm, err := mapper.SchemaFromStruct(struct {
+					Attributes string `terraform:"attributes,json"`
+				}{}, nil)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sm["attributes"].StateFunc).ShouldNot(BeNil())
+				Expect(sm["attributes"].DiffSuppressFunc("attributes", `{"a":1,"b":2}`, `{"b": 2, "a": 1}`, nil)).To(Equal(true))
+
+				_, errs := sm["attributes"].ValidateFunc(`{"a": }`, "attributes")
+				Expect(errs).ShouldNot(BeEmpty())
+			})
+
+			It("should error on attributes that aren't strings", func() {
+				_, err := mapper.SchemaFromStruct(struct {
+					Attributes map[string]string `terraform:"attributes,json"`
+				}{}, nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		Context("When a field is write-only", func() {
+			type WeaponReservation struct {
+				Name       string `terraform:"name,required"`
+				HoldPeriod *int   `terraform:"hold_period,writeonly"`
+			}
+
+			It("should keep the configured value when marshalling to Terraform", func() {
+				sm, err := mapper.SchemaFromStruct(&WeaponReservation{}, nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sm["hold_period"].Optional).To(Equal(true))
+
+				tfdata := planResourceData(sm, nil, map[string]interface{}{
+					"name":        "Splattershot",
+					"hold_period": 3600,
+				})
+
+				Expect(mapper.MarshalToTerraform(&WeaponReservation{Name: "Splattershot Jr."}, tfdata, sm)).ShouldNot(HaveOccurred())
+				Expect(tfdata.Get("name")).To(Equal("Splattershot Jr."))
+				Expect(tfdata.Get("hold_period")).To(Equal(3600))
+			})
+
+			It("should error when it is also computed", func() {
+				_, err := mapper.SchemaFromStruct(struct {
+					HoldPeriod *int `terraform:"hold_period,optional,computed,writeonly"`
+				}{}, nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		Context("When the tags are invalid", func() {
+
+			It("should error on unknown options", func() {
+				_, err := mapper.SchemaFromStruct(struct {
+					Name string `terraform:"name,requried"`
+				}{}, nil)
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should error on unknown validators", func() {
+				_, err := mapper.SchemaFromStruct(struct {
+					Name string `terraform:"name,validate=phone"`
+				}{}, nil)
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should error on conflicting options", func() {
+				_, err := mapper.SchemaFromStruct(struct {
+					Name string `terraform:"name,required,computed"`
+				}{}, nil)
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should error on something other than a struct", func() {
+				_, err := mapper.SchemaFromStruct("Kensa Splat Roller", nil)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,317 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+)
+
+// SchemaOptions configures SchemaFromStruct.
+type SchemaOptions struct {
+	// Validators maps the names used in `validate=` tag options to validation functions, in addition to the built-in
+	// "json" and "rfc3339".
+	Validators map[string]schema.SchemaValidateFunc
+}
+
+var builtInValidators = map[string]schema.SchemaValidateFunc{
+	"json":    jsonattr.ValidateFunc,
+	"rfc3339": validation.ValidateRFC3339TimeString,
+}
+
+// SchemaFromStruct derives a Terraform schema from the `terraform` tags present on the fields of a struct (`src`),
+// so that the struct mapped by MarshalToTerraform and UnmarshalFromTerraform also describes the attributes.
+//
+// The attribute type follows the field's type: strings (including times and twilio-go's PhoneNumber and TwilioTime),
+// bools, ints and floats map to the matching primitive, slices to lists, maps to TypeMap and structs to nested blocks.
+// Options follow the attribute name in the tag, e.g. `terraform:"voice_method,default=POST,oneof=GET|POST"`:
+//
+//	required, optional, computed   how the attribute is set; fields are optional by default and `computed` alone
+//	                               makes the attribute read-only
+//	forcenew, sensitive            the matching schema flags
+//	writeonly                      the attribute is sent but never read back, so MarshalToTerraform leaves it alone
+//	json                           the string holds a JSON document, set up with jsonattr.Attribute
+//	default=VALUE                  the default value, parsed according to the attribute type
+//	set                            a slice or struct becomes a TypeSet instead of a TypeList
+//	minitems=N, maxitems=N         bounds on a list or set
+//	min=N, max=N                   bounds on an int
+//	oneof=A|B|C                    the allowed values of a string
+//	validate=NAME                  a validation function from SchemaOptions.Validators
+//
+// The `id` attribute is Terraform's ID rather than an attribute, so it is skipped.
+func SchemaFromStruct(src interface{}, opts *SchemaOptions) (map[string]*schema.Schema, error) {
+	if opts == nil {
+		opts = &SchemaOptions{}
+	}
+
+	t := reflect.TypeOf(src)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("src cannot be nil and must be a struct")
+	}
+
+	return schemaFromStructType(t, opts, false)
+}
+
+func schemaFromStructType(t reflect.Type, opts *SchemaOptions, computedParent bool) (map[string]*schema.Schema, error) {
+	sm := make(map[string]*schema.Schema)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		options := strings.Split(field.Tag.Get("terraform"), ",")
+		terraformFieldName := options[0]
+		if terraformFieldName == "" || terraformFieldName == "-" || terraformFieldName == TerraformIDFieldName {
+			continue
+		}
+
+		s, err := schemaFromField(field.Type, options[1:], opts, computedParent)
+		if err != nil {
+			return nil, fmt.Errorf("Terraform field `%s`: %s", terraformFieldName, err)
+		}
+		sm[terraformFieldName] = s
+	}
+
+	return sm, nil
+}
+
+func schemaFromField(t reflect.Type, options []string, opts *SchemaOptions, computedParent bool) (*schema.Schema, error) {
+	s := &schema.Schema{}
+
+	var (
+		asSet              bool
+		asJSON             bool
+		optional           bool
+		writeOnly          bool
+		defaultValue       *string
+		minValue, maxValue *int
+		minItems, maxItems *int
+		validateFuncs      []schema.SchemaValidateFunc
+	)
+
+	for _, option := range options {
+		name, value := option, ""
+		if i := strings.Index(option, "="); i >= 0 {
+			name, value = option[:i], option[i+1:]
+		}
+
+		var err error
+		switch name {
+		case "required":
+			s.Required = true
+		case "optional":
+			optional = true
+		case "computed":
+			s.Computed = true
+		case "forcenew":
+			s.ForceNew = true
+		case "sensitive":
+			s.Sensitive = true
+		case "writeonly":
+			writeOnly = true
+		case "set":
+			asSet = true
+		case "json":
+			asJSON = true
+		case "default":
+			defaultValue = &value
+		case "minitems":
+			minItems, err = parseIntOption(value)
+		case "maxitems":
+			maxItems, err = parseIntOption(value)
+		case "min":
+			minValue, err = parseIntOption(value)
+		case "max":
+			maxValue, err = parseIntOption(value)
+		case "oneof":
+			validateFuncs = append(validateFuncs, validation.StringInSlice(strings.Split(value, "|"), false))
+		case "validate":
+			validateFunc, ok := opts.Validators[value]
+			if !ok {
+				validateFunc, ok = builtInValidators[value]
+			}
+			if !ok {
+				return nil, fmt.Errorf("unknown validator %q", value)
+			}
+			validateFuncs = append(validateFuncs, validateFunc)
+		default:
+			return nil, fmt.Errorf("unknown option %q", option)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("option %q: %s", option, err)
+		}
+	}
+
+	switch {
+	case s.Required && (optional || s.Computed):
+		return nil, fmt.Errorf("a required attribute cannot be optional or computed")
+	case writeOnly && s.Computed:
+		return nil, fmt.Errorf("a write-only attribute cannot be computed")
+	case s.Computed && !optional:
+		// Read-only.
+	case !s.Required:
+		s.Optional = true
+	}
+	if computedParent && !s.Required && !optional {
+		s.Computed = true
+		s.Optional = false
+	}
+
+	if err := setSchemaType(s, t, asSet, opts); err != nil {
+		return nil, err
+	}
+
+	if defaultValue != nil {
+		value, err := parseDefault(s.Type, *defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		s.Default = value
+	}
+
+	if minValue != nil || maxValue != nil {
+		if s.Type != schema.TypeInt {
+			return nil, fmt.Errorf("min and max only apply to ints")
+		}
+		switch {
+		case minValue != nil && maxValue != nil:
+			validateFuncs = append(validateFuncs, validation.IntBetween(*minValue, *maxValue))
+		case minValue != nil:
+			validateFuncs = append(validateFuncs, validation.IntAtLeast(*minValue))
+		default:
+			validateFuncs = append(validateFuncs, validation.IntAtMost(*maxValue))
+		}
+	}
+
+	switch len(validateFuncs) {
+	case 0:
+	case 1:
+		s.ValidateFunc = validateFuncs[0]
+	default:
+		s.ValidateFunc = validation.All(validateFuncs...)
+	}
+
+	if asJSON {
+		if s.Type != schema.TypeString {
+			return nil, fmt.Errorf("json only applies to strings")
+		}
+		jsonattr.Attribute(s)
+	}
+
+	if minItems != nil || maxItems != nil {
+		if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+			return nil, fmt.Errorf("minitems and maxitems only apply to lists and sets")
+		}
+		if minItems != nil {
+			s.MinItems = *minItems
+		}
+		if maxItems != nil {
+			s.MaxItems = *maxItems
+		}
+	}
+
+	return s, nil
+}
+
+func setSchemaType(s *schema.Schema, t reflect.Type, asSet bool, opts *SchemaOptions) error {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	listType := schema.TypeList
+	if asSet {
+		listType = schema.TypeSet
+	}
+
+	if t == timeType || t == twilioTimeType {
+		s.Type = schema.TypeString
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		s.Type = schema.TypeString
+	case reflect.Bool:
+		s.Type = schema.TypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s.Type = schema.TypeInt
+	case reflect.Float32, reflect.Float64:
+		s.Type = schema.TypeFloat
+	case reflect.Struct:
+		nested, err := schemaFromStructType(t, opts, s.Computed && !s.Optional)
+		if err != nil {
+			return err
+		}
+		s.Type = listType
+		s.MaxItems = 1
+		s.Elem = &schema.Resource{Schema: nested}
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		s.Type = listType
+		if elem.Kind() == reflect.Struct && elem != timeType && elem != twilioTimeType {
+			nested, err := schemaFromStructType(elem, opts, s.Computed && !s.Optional)
+			if err != nil {
+				return err
+			}
+			s.Elem = &schema.Resource{Schema: nested}
+			return nil
+		}
+		elemSchema := &schema.Schema{}
+		if err := setSchemaType(elemSchema, elem, false, opts); err != nil {
+			return err
+		}
+		s.Elem = elemSchema
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return fmt.Errorf("map keys must be strings")
+		}
+		elemSchema := &schema.Schema{}
+		if err := setSchemaType(elemSchema, t.Elem(), false, opts); err != nil {
+			return err
+		}
+		if elemSchema.Elem != nil {
+			return fmt.Errorf("map values must be primitives")
+		}
+		s.Type = schema.TypeMap
+		s.Elem = elemSchema
+	default:
+		return fmt.Errorf("unsupported type %s", t)
+	}
+
+	return nil
+}
+
+func parseIntOption(value string) (*int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func parseDefault(valueType schema.ValueType, value string) (interface{}, error) {
+	switch valueType {
+	case schema.TypeString:
+		return value, nil
+	case schema.TypeBool:
+		return strconv.ParseBool(value)
+	case schema.TypeInt:
+		return strconv.Atoi(value)
+	case schema.TypeFloat:
+		return strconv.ParseFloat(value, 64)
+	}
+	return nil, fmt.Errorf("a %s cannot have a default", valueType)
+}
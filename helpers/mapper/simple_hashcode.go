@@ -3,40 +3,109 @@ package mapper
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
 
 	"github.com/fatih/structs"
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// SimpleHashcode calculates a simple integer hashcode by iterating over all the fields/keys in a map,
-// concating the values in buffer, then calculating the hashcode of that buffer.
+// SimpleHashcode calculates a simple integer hashcode by writing every field/key of a struct or map, sorted by name,
+// together with its value into a buffer, then calculating the hashcode of that buffer. Nested structs, maps, slices,
+// sets and pointers are written recursively, so equal values always hash the same.
 func SimpleHashcode(v interface{}) int {
 	var buf bytes.Buffer
 
-	if structs.IsStruct(v) {
-		for _, value := range structs.Map(v) {
-			if value != nil {
-				buf.WriteString(value.(string))
-			} else {
-				buf.WriteString("nil")
+	writeHashValue(&buf, reflect.ValueOf(v))
+
+	return hashcode.String(buf.String())
+}
+
+// HashcodeByKeys returns a schema.SchemaSetFunc hashing only the given keys of each set item (a
+// map[string]interface{}, or a struct's fields). Items with the same keys are then treated as the same item, so a
+// change to any other attribute shows up as an in-place change rather than as a removal and an addition.
+func HashcodeByKeys(keys ...string) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		var values map[string]interface{}
+
+		switch {
+		case structs.IsStruct(v):
+			values = structs.Map(v)
+		default:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return SimpleHashcode(v)
 			}
+			values = m
 		}
-	} else {
-		switch v.(type) {
-		case map[string]interface{}:
-			for _, value := range v.(map[string]interface{}) {
-				if value != nil {
-					buf.WriteString(fmt.Sprintf("%v", value))
-				} else {
-					buf.WriteString("nil")
-				}
-			}
-		default:
-			return -1
+
+		keyValues := make(map[string]interface{}, len(keys))
+		for _, key := range keys {
+			keyValues[key] = values[key]
 		}
+
+		return SimpleHashcode(keyValues)
 	}
+}
 
-	result := hashcode.String(buf.String())
+func writeHashValue(buf *bytes.Buffer, v reflect.Value) {
+	if v.IsValid() {
+		if set, ok := v.Interface().(*schema.Set); ok && set != nil {
+			writeHashValue(buf, reflect.ValueOf(set.List()))
+			return
+		}
+	}
 
-	return result
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			buf.WriteString("nil")
+			return
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		buf.WriteString("nil")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			buf.WriteString(strconv.Quote(stringer.String()))
+			return
+		}
+		writeHashValue(buf, reflect.ValueOf(structs.Map(v.Interface())))
+	case reflect.Map:
+		keys := v.MapKeys()
+		names := make([]string, len(keys))
+		byName := make(map[string]reflect.Value, len(keys))
+		for i, key := range keys {
+			names[i] = fmt.Sprintf("%v", key.Interface())
+			byName[names[i]] = key
+		}
+		sort.Strings(names)
+
+		buf.WriteString("{")
+		for _, name := range names {
+			buf.WriteString(strconv.Quote(name))
+			buf.WriteString("=")
+			writeHashValue(buf, v.MapIndex(byName[name]))
+			buf.WriteString(";")
+		}
+		buf.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		buf.WriteString("[")
+		for i := 0; i < v.Len(); i++ {
+			writeHashValue(buf, v.Index(i))
+			buf.WriteString(",")
+		}
+		buf.WriteString("]")
+	case reflect.String:
+		buf.WriteString(strconv.Quote(v.String()))
+	default:
+		buf.WriteString(fmt.Sprintf("%v", v.Interface()))
+	}
 }
@@ -2,12 +2,14 @@ package mapper
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/fatih/structs"
-
-	log "github.com/sirupsen/logrus"
+	twiclient "github.com/kaiquelupo/twilio-go"
 )
 
 // TerraformIDFieldName is a special field name that can be used to identify the value that should be storedin/retrieved from
@@ -16,6 +18,11 @@ const TerraformIDFieldName = "id"
 
 // MarshalToTerraform takes a source struct (`src`), a destination Terraform *ResourceData (`dest`), and a Terraform schema map[string]*Schema
 // and then marshals the source data into the destination data given a `terraform` tag present on the fields in the source struct.
+//
+// Values are converted to the type of their schema entry: pointers are followed, structs (or slices of structs) fill
+// TypeList and TypeSet attributes whose Elem is a *schema.Resource, maps fill TypeMap attributes, and times (time.Time
+// or twilio-go's TwilioTime) are written as RFC 3339 strings. A tagged field with no schema entry is an error. Fields
+// tagged `writeonly` are skipped, so that the configured value of attributes Twilio never returns is kept.
 func MarshalToTerraform(src interface{}, dest *schema.ResourceData, sm map[string]*schema.Schema) error {
 	if src == nil || !structs.IsStruct(src) {
 		return fmt.Errorf("src cannot be nil and must be a struct")
@@ -35,34 +42,389 @@ func MarshalToTerraform(src interface{}, dest *schema.ResourceData, sm map[strin
 		return fmt.Errorf("Failed to map values: %s", err)
 	}
 
+	writeOnly := writeOnlyFields(src)
+
 	for terraformFieldName, sourceValue := range mv {
+		if writeOnly[terraformFieldName] {
+			continue
+		}
+
 		if terraformFieldName == TerraformIDFieldName {
-			dest.SetId(fmt.Sprintf("%s", sourceValue))
-		} else {
-			switch sm[terraformFieldName].Type {
-			case schema.TypeSet:
-				nestedSet := schema.NewSet(SimpleHashcode, nil)
-
-				if !structs.IsStruct(sourceValue) {
-					return fmt.Errorf("Terraform field `%s` is a Set, but target value is not a struct", terraformFieldName)
-				}
-
-				mappedValue, err := MapStructByTag(sourceValue, "terraform")
-				nestedSet.Add(mappedValue)
-
-				if err != nil {
-					log.Errorf("Unable to marshal %s to terraform struct map", terraformFieldName)
-				}
-
-				err = dest.Set(fmt.Sprintf("%s", terraformFieldName), nestedSet)
-
-				if err != nil {
-					return fmt.Errorf("Setting `%s` failed: %s", terraformFieldName, err)
-				}
-			default:
-				dest.Set(terraformFieldName, sourceValue)
+			id, err := toTerraformValue(sourceValue, &schema.Schema{Type: schema.TypeString})
+			if err != nil {
+				return fmt.Errorf("Converting `%s` failed: %s", terraformFieldName, err)
 			}
+
+			idString, _ := id.(string)
+			dest.SetId(idString)
+			continue
+		}
+
+		s, ok := sm[terraformFieldName]
+		if !ok {
+			return fmt.Errorf("Terraform field `%s` is not in the schema", terraformFieldName)
+		}
+
+		value, err := toTerraformValue(sourceValue, s)
+		if err != nil {
+			return fmt.Errorf("Converting `%s` failed: %s", terraformFieldName, err)
+		}
+
+		if err := dest.Set(terraformFieldName, value); err != nil {
+			return fmt.Errorf("Setting `%s` failed: %s", terraformFieldName, err)
 		}
 	}
 	return nil
 }
+
+// writeOnlyFields returns the attributes whose `terraform` tag has the `writeonly` option.
+func writeOnlyFields(src interface{}) map[string]bool {
+	fields := make(map[string]bool)
+	for _, field := range structs.Fields(src) {
+		options := strings.Split(field.Tag("terraform"), ",")
+		for _, option := range options[1:] {
+			if option == "writeonly" {
+				fields[options[0]] = true
+			}
+		}
+	}
+	return fields
+}
+
+// toTerraformValue converts a struct field's value to what ResourceData.Set expects for the schema entry.
+func toTerraformValue(value interface{}, s *schema.Schema) (interface{}, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		return toTerraformList(v, s)
+	case schema.TypeMap:
+		return toTerraformMap(v, s)
+	case schema.TypeString:
+		return toTerraformString(v)
+	case schema.TypeBool:
+		if v.Kind() != reflect.Bool {
+			return nil, fmt.Errorf("cannot convert %s to a bool", v.Type())
+		}
+		return v.Bool(), nil
+	case schema.TypeInt:
+		if kindClass(v.Kind()) != "number" {
+			return nil, fmt.Errorf("cannot convert %s to an int", v.Type())
+		}
+		return int(v.Convert(reflect.TypeOf(int64(0))).Int()), nil
+	case schema.TypeFloat:
+		if kindClass(v.Kind()) != "number" {
+			return nil, fmt.Errorf("cannot convert %s to a float", v.Type())
+		}
+		return v.Convert(reflect.TypeOf(float64(0))).Float(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported schema type %s", s.Type)
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	twilioTimeType = reflect.TypeOf(twiclient.TwilioTime{})
+)
+
+func toTerraformString(v reflect.Value) (interface{}, error) {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(time.RFC3339), nil
+	case twilioTimeType:
+		t := v.Interface().(twiclient.TwilioTime)
+		if !t.Valid {
+			return "", nil
+		}
+		return t.Time.Format(time.RFC3339), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return nil, fmt.Errorf("cannot convert %s to a string", v.Type())
+	}
+	return fmt.Sprintf("%v", v.Interface()), nil
+}
+
+// toTerraformList converts a slice (or a single struct, for a nested block) to the []interface{} ResourceData.Set
+// expects for both lists and sets; sets are hashed with the schema's Set function.
+func toTerraformList(v reflect.Value, s *schema.Schema) (interface{}, error) {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		if v.Kind() != reflect.Struct || v.Type() == timeType || v.Type() == twilioTimeType {
+			return nil, fmt.Errorf("cannot convert %s to a %s", v.Type(), s.Type)
+		}
+		item, err := toTerraformElem(v.Interface(), s.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{item}, nil
+	}
+
+	list := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item, err := toTerraformElem(v.Index(i).Interface(), s.Elem)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %s", i, err)
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+func toTerraformMap(v reflect.Value, s *schema.Schema) (interface{}, error) {
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("cannot convert %s to a map", v.Type())
+	}
+
+	m := make(map[string]interface{}, v.Len())
+	for _, key := range v.MapKeys() {
+		item, err := toTerraformElem(v.MapIndex(key).Interface(), s.Elem)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %s", key.String(), err)
+		}
+		m[key.String()] = item
+	}
+	return m, nil
+}
+
+// toTerraformElem converts an item of a list, set or map according to the attribute's Elem, which is either a
+// *schema.Schema, a *schema.Resource holding a nested block, or nil for a map of strings.
+func toTerraformElem(value interface{}, elem interface{}) (interface{}, error) {
+	switch elem := elem.(type) {
+	case *schema.Schema:
+		return toTerraformValue(value, elem)
+	case *schema.Resource:
+		return toTerraformResource(value, elem)
+	}
+	return toTerraformValue(value, &schema.Schema{Type: schema.TypeString})
+}
+
+// toTerraformResource converts a struct to the map of attributes of a nested block.
+func toTerraformResource(value interface{}, resource *schema.Resource) (interface{}, error) {
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot convert %s to a nested block", v.Type())
+	}
+
+	mv, err := MapStructByTag(v.Interface(), "terraform")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{}, len(mv))
+	for terraformFieldName, sourceValue := range mv {
+		s, ok := resource.Schema[terraformFieldName]
+		if !ok {
+			return nil, fmt.Errorf("Terraform field `%s` is not in the schema", terraformFieldName)
+		}
+
+		item, err := toTerraformValue(sourceValue, s)
+		if err != nil {
+			return nil, fmt.Errorf("Converting `%s` failed: %s", terraformFieldName, err)
+		}
+		result[terraformFieldName] = item
+	}
+	return result, nil
+}
+
+// UnmarshalFromTerraform is the reverse of MarshalToTerraform: it copies the attributes named by the `terraform` tags on
+// the fields of `dest`, which must be a pointer to a struct, out of the Terraform *ResourceData (`src`).
+//
+// It is meant for building create and update parameters, so only attributes that are set in the plan or that changed
+// are copied; every other field is left alone. Use pointer fields to tell a cleared attribute (a pointer to the zero
+// value) apart from one that wasn't touched (nil). On create (when `src` has no ID yet), every attribute present in
+// the configuration is copied, as one set to its zero value, e.g. `false`, has no change to show for it.
+func UnmarshalFromTerraform(src *schema.ResourceData, dest interface{}) error {
+	if src == nil {
+		return fmt.Errorf("src cannot be null")
+	}
+
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dest must be a pointer to a struct")
+	}
+	destValue = destValue.Elem()
+
+	for i := 0; i < destValue.NumField(); i++ {
+		field := destValue.Type().Field(i)
+
+		terraformFieldName := strings.Split(field.Tag.Get("terraform"), ",")[0]
+		if terraformFieldName == "" || terraformFieldName == TerraformIDFieldName || field.PkgPath != "" {
+			continue
+		}
+
+		if !src.HasChange(terraformFieldName) {
+			if src.Id() != "" {
+				continue
+			}
+			if _, ok := src.GetOkExists(terraformFieldName); !ok {
+				continue
+			}
+		}
+
+		if err := setFieldValue(destValue.Field(i), src.Get(terraformFieldName)); err != nil {
+			return fmt.Errorf("Reading `%s` failed: %s", terraformFieldName, err)
+		}
+	}
+
+	return nil
+}
+
+// setFieldValue stores a value read from Terraform into a struct field, converting between Terraform's types (lists,
+// sets and maps of interface{}) and the field's type. A nested block (a single-item list of maps) is read into a
+// struct field; an empty block leaves a pointer to a struct nil.
+func setFieldValue(field reflect.Value, value interface{}) error {
+	if set, ok := value.(*schema.Set); ok {
+		value = set.List()
+	}
+
+	switch field.Kind() {
+	case reflect.Ptr:
+		if list, ok := value.([]interface{}); ok && len(list) == 0 && field.Type().Elem().Kind() == reflect.Struct {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		elem := reflect.New(field.Type().Elem())
+		if err := setFieldValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("cannot read %T into %s", value, field.Type())
+		}
+		slice := reflect.MakeSlice(field.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := setFieldValue(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	case reflect.Map:
+		m, ok := value.(map[string]interface{})
+		if !ok || field.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("cannot read %T into %s", value, field.Type())
+		}
+		result := reflect.MakeMapWithSize(field.Type(), len(m))
+		for key, item := range m {
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setFieldValue(elem, item); err != nil {
+				return err
+			}
+			result.SetMapIndex(reflect.ValueOf(key).Convert(field.Type().Key()), elem)
+		}
+		field.Set(result)
+		return nil
+	case reflect.Struct:
+		return setStructValue(field, value)
+	}
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	if kindClass(v.Kind()) == "" || kindClass(v.Kind()) != kindClass(field.Kind()) {
+		return fmt.Errorf("cannot read %T into %s", value, field.Type())
+	}
+	field.Set(v.Convert(field.Type()))
+	return nil
+}
+
+// setStructValue reads a nested block into a struct. Terraform reports every attribute of a block, so attributes left
+// at their zero value are treated as unset and leave pointer fields nil.
+func setStructValue(field reflect.Value, value interface{}) error {
+	if list, ok := value.([]interface{}); ok {
+		switch len(list) {
+		case 0:
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		case 1:
+			value = list[0]
+		default:
+			return fmt.Errorf("cannot read %d blocks into %s", len(list), field.Type())
+		}
+	}
+
+	block, ok := value.(map[string]interface{})
+	if !ok {
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		return fmt.Errorf("cannot read %T into %s", value, field.Type())
+	}
+
+	for i := 0; i < field.NumField(); i++ {
+		structField := field.Type().Field(i)
+
+		terraformFieldName := strings.Split(structField.Tag.Get("terraform"), ",")[0]
+		if terraformFieldName == "" || terraformFieldName == "-" || structField.PkgPath != "" {
+			continue
+		}
+
+		item, ok := block[terraformFieldName]
+		if !ok || item == nil {
+			continue
+		}
+		if structField.Type.Kind() == reflect.Ptr && isZeroTerraformValue(item) {
+			continue
+		}
+
+		if err := setFieldValue(field.Field(i), item); err != nil {
+			return fmt.Errorf("`%s`: %s", terraformFieldName, err)
+		}
+	}
+
+	return nil
+}
+
+func isZeroTerraformValue(value interface{}) bool {
+	if set, ok := value.(*schema.Set); ok {
+		return set.Len() == 0
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+}
+
+// kindClass groups the kinds that can safely be converted into one another.
+func kindClass(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return ""
+}
@@ -1,6 +1,15 @@
 package mapper
 
-import "net/url"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/structs"
+)
 
 // MarshalMapToURLValues creates a url.Values from a map[string]string
 func MarshalMapToURLValues(m map[string]string) url.Values {
@@ -12,3 +21,91 @@ func MarshalMapToURLValues(m map[string]string) url.Values {
 
 	return u
 }
+
+// MarshalToURLValues creates a url.Values from a struct, given a `form` tag present on the fields in the struct. The tag
+// should be of the format `form:"ParamName"` or `form:"ParamName,omitempty"`.
+//
+// Nil pointers are always left out, as are zero values of fields marked `omitempty`. Slices become repeated
+// parameters, e.g. `EventsFilter=a&EventsFilter=b`, and the fields of nested structs are prefixed with the struct's
+// name, e.g. `AutoCreation.Enabled`.
+func MarshalToURLValues(src interface{}) (url.Values, error) {
+	if src == nil || !structs.IsStruct(src) {
+		return nil, errors.New("Source cannot be nil and must be a struct")
+	}
+
+	u := make(url.Values)
+	if err := marshalURLValues(u, "", src); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func marshalURLValues(u url.Values, prefix string, src interface{}) error {
+	for _, field := range structs.Fields(src) {
+		tag := field.Tag("form")
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		options := strings.Split(tag, ",")
+		name := prefix + options[0]
+		omitEmpty := len(options) > 1 && options[1] == "omitempty"
+
+		value := reflect.ValueOf(field.Value())
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		} else if omitEmpty && field.IsZero() {
+			continue
+		}
+
+		if _, ok := value.Interface().(fmt.Stringer); !ok && value.Kind() == reflect.Struct {
+			if err := marshalURLValues(u, name+".", value.Interface()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				formatted, err := formatURLValue(value.Index(i))
+				if err != nil {
+					return fmt.Errorf("Encoding `%s` failed: %s", name, err)
+				}
+				u.Add(name, formatted)
+			}
+			continue
+		}
+
+		formatted, err := formatURLValue(value)
+		if err != nil {
+			return fmt.Errorf("Encoding `%s` failed: %s", name, err)
+		}
+		u.Add(name, formatted)
+	}
+
+	return nil
+}
+
+func formatURLValue(value reflect.Value) (string, error) {
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", value.Type())
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func init() {
-	log.SetLevel(log.DebugLevel)
+	twilio.ConfigureLogging()
 }
 
 func main() {
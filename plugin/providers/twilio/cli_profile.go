@@ -0,0 +1,73 @@
+package twilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// twilioCLIConfig is the subset of the Twilio CLI's `~/.twilio-cli/config.json` the provider understands.
+type twilioCLIConfig struct {
+	Edge     string                       `json:"edge"`
+	Profiles map[string]*twilioCLIProfile `json:"profiles"`
+}
+
+// twilioCLIProfile is a login created with `twilio profiles:add` (or `twilio login`).
+type twilioCLIProfile struct {
+	AccountSid string `json:"accountSid"`
+	APIKey     string `json:"apiKey"`
+	APISecret  string `json:"apiSecret"`
+	Region     string `json:"region"`
+}
+
+// twilioCLIConfigPath returns the location of the Twilio CLI's configuration file.
+func twilioCLIConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".twilio-cli", "config.json"), nil
+}
+
+// loadTwilioCLIProfile fills in any setting that isn't configured explicitly (or via the environment) from the
+// named Twilio CLI profile.
+func loadTwilioCLIProfile(config *Config, name string) error {
+	path, err := twilioCLIConfigPath()
+	if err != nil {
+		return fmt.Errorf("Failed to locate the Twilio CLI configuration: %s", err.Error())
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read the Twilio CLI configuration: %s", err.Error())
+	}
+
+	cliConfig := new(twilioCLIConfig)
+	if err := json.Unmarshal(raw, cliConfig); err != nil {
+		return fmt.Errorf("Failed to parse %s: %s", path, err.Error())
+	}
+
+	profile, ok := cliConfig.Profiles[name]
+	if !ok {
+		return fmt.Errorf("Profile %q not found in %s", name, path)
+	}
+
+	if config.AccountSID == "" {
+		config.AccountSID = profile.AccountSid
+	}
+	// An explicitly configured auth token or API key wins over the profile's key.
+	if config.AuthToken == "" && config.APIKey == "" {
+		config.APIKey = profile.APIKey
+		config.APISecret = profile.APISecret
+	}
+	if config.Region == "" {
+		config.Region = profile.Region
+	}
+	if config.Edge == "" {
+		config.Edge = cliConfig.Edge
+	}
+
+	return nil
+}
@@ -3,27 +3,50 @@ package twilio
 import (
 	log "github.com/sirupsen/logrus"
 	"context"
+	"net/http"
+	"net/url"
+	"strings"
 	twiclient "github.com/kaiquelupo/twilio-go"
-	twiclientServerless "github.com/kaiquelupo/twilio-go-serverless"
 )
 
 // Config contains our different configuration attributes and instantiates our Twilio client.
 type Config struct {
-	AccountSID string
-	AuthToken  string
-	Endpoint   string
+	AccountSID    string
+	AuthToken     string
+	APIKey        string
+	APISecret     string
+	SubaccountSID string
+	Region        string
+	Edge          string
+	MaxRetries    int
+	Endpoint      string
+
+	RequestsPerSecond        float64
+	ProductRequestsPerSecond map[string]float64
+
+	LogHTTPTraffic     bool
+	RedactPhoneNumbers bool
 }
 
 // TerraformTwilioContext is our Terraform context that will contain both our Twilio client and configuration for access downstream.
 type TerraformTwilioContext struct {
-	client        		*twiclient.Client
-	clientServerless 	*twiclientServerless.APIClient
+	client        *twiclient.Client
+	messaging     *twiclient.Client
+	studio        *twiclient.Client
+	trunking      *twiclient.Client
+	iam           *twiclient.Client
+	conversations *twiclient.Client
+	sync          *twiclient.Client
+	verify        *twiclient.Client
+	proxy         *twiclient.Client
+	notify        *twiclient.Client
+	events        *twiclient.Client
 	configuration Config
-	auth	*context.Context
+	stopContext   context.Context
 }
 
 // Client creates a Twilio client and prepares it for use with Terraform.
-func (config *Config) Client() (interface{}, error) {
+func (config *Config) Client() (*TerraformTwilioContext, error) {
 	log.WithFields(
 		log.Fields{
 			"account_sid": config.AccountSID,
@@ -32,26 +55,140 @@ func (config *Config) Client() (interface{}, error) {
 
 	// TODO Support unique endpoints
 
-	client := twiclient.NewClient(config.AccountSID, config.AuthToken, nil)
-
+	username, password := config.credentials()
+	httpClient := newHTTPClient(config)
 
-	//Twilio Serverless API
-	cfg := sw.NewConfiguration()
-	cfg.Host = "serverless.twilio.com"
-	cfg.Scheme = "https"
-	clientServerless = sw.NewAPIClient(cfg)
-	auth := context.WithValue(context.Background(), sw.ContextBasicAuth, sw.BasicAuth{
-		UserName: config.AccountSID,
-		Password: config.AuthToken,
-	})
-	// ---
+	client := twiclient.NewClient(config.AccountSID, password, httpClient)
+	if username != config.AccountSID {
+		useUsername(client, username)
+	}
+	if config.SubaccountSID != "" {
+		// The 2010-04-01 API takes the account in the URL, so the parent's credentials stay on the main client.
+		client.ID = config.AccountSID
+		client.RequestOnBehalfOf(config.SubaccountSID)
+	}
+	useRegion(client, config)
+	messaging := newProductClient(config, "https://messaging.twilio.com", "v1", httpClient)
+	studio := newProductClient(config, "https://studio.twilio.com", "v2", httpClient)
+	trunking := newProductClient(config, "https://trunking.twilio.com", "v1", httpClient)
+	iam := newProductClient(config, "https://iam.twilio.com", "v1", httpClient)
+	conversations := newProductClient(config, "https://conversations.twilio.com", "v1", httpClient)
+	sync := newProductClient(config, "https://sync.twilio.com", "v1", httpClient)
+	verify := newProductClient(config, "https://verify.twilio.com", "v2", httpClient)
+	proxy := newProductClient(config, "https://proxy.twilio.com", "v1", httpClient)
+	notify := newProductClient(config, "https://notify.twilio.com", "v1", httpClient)
+	events := newProductClient(config, "https://events.twilio.com", "v1", httpClient)
 
 	context := TerraformTwilioContext{
 		client:        client,
-		clientServerless: clientServerless,
-		auth: auth,
+		messaging:     messaging,
+		studio:        studio,
+		trunking:      trunking,
+		iam:           iam,
+		conversations: conversations,
+		sync:          sync,
+		verify:        verify,
+		proxy:         proxy,
+		notify:        notify,
+		events:        events,
 		configuration: *config,
 	}
 
 	return &context, nil
 }
+
+// newProductClient creates a Twilio client for one of the versioned product APIs (e.g. https://messaging.twilio.com/v1)
+// that twilio-go doesn't ship a constructor for. The Wireless client is used as the template since it shares the same
+// form-encoded transport and error parsing; only the base URL and API version are swapped out.
+func newProductClient(config *Config, baseURL string, version string, httpClient *http.Client) *twiclient.Client {
+	username, password := config.credentials()
+
+	c := twiclient.NewWirelessClient(config.AccountSID, password, httpClient)
+	c.ID = username
+	c.Base = config.regionalURL(baseURL)
+	c.APIVersion = version
+	return c
+}
+
+// regionalHost routes a Twilio hostname (e.g. api.twilio.com) through the configured region and edge location, as in
+// api.sydney.au1.twilio.com. Setting only an edge implies the default us1 region.
+func (config *Config) regionalHost(host string) string {
+	if config.Region == "" && config.Edge == "" {
+		return host
+	}
+
+	parts := strings.SplitN(host, ".", 2)
+	if len(parts) != 2 {
+		return host
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "us1"
+	}
+
+	pieces := []string{parts[0]}
+	if config.Edge != "" {
+		pieces = append(pieces, config.Edge)
+	}
+	pieces = append(pieces, region, parts[1])
+
+	return strings.Join(pieces, ".")
+}
+
+// regionalURL applies regionalHost to the host of a base URL.
+func (config *Config) regionalURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL
+	}
+	u.Host = config.regionalHost(u.Host)
+	return u.String()
+}
+
+// useRegion points a twilio-go client and all of its product clients at the configured region and edge location.
+func useRegion(c *twiclient.Client, config *Config) {
+	c.Base = config.regionalURL(c.Base)
+	for _, productClient := range productClients(c) {
+		if productClient != nil {
+			productClient.Base = config.regionalURL(productClient.Base)
+		}
+	}
+}
+
+// managedAccountSID returns the SID of the account resources are managed in: the subaccount when `subaccount_sid` is
+// set, otherwise the account the provider authenticates as.
+func (config *Config) managedAccountSID() string {
+	if config.SubaccountSID != "" {
+		return config.SubaccountSID
+	}
+	return config.AccountSID
+}
+
+// credentials returns the username and password used for HTTP basic auth: either an account SID and the auth token, or
+// an API key and its secret. The product APIs don't take the account in the URL, so when managing a subaccount they
+// are authenticated as the subaccount SID with the parent's auth token.
+func (config *Config) credentials() (string, string) {
+	if config.APIKey != "" {
+		return config.APIKey, config.APISecret
+	}
+	return config.managedAccountSID(), config.AuthToken
+}
+
+// useUsername authenticates a twilio-go client and all of its product clients with the given basic auth username
+// rather than the account SID. twilio-go's own UseSecretKey only covers some of the product clients.
+func useUsername(c *twiclient.Client, username string) {
+	c.ID = username
+	for _, productClient := range productClients(c) {
+		if productClient != nil {
+			productClient.ID = username
+		}
+	}
+}
+
+// productClients lists the product API clients twilio-go hangs off its main client.
+func productClients(c *twiclient.Client) []*twiclient.Client {
+	return []*twiclient.Client{
+		c.Monitor, c.Pricing, c.Fax, c.Wireless, c.Notify, c.Lookup, c.Verify, c.Video, c.TaskRouter, c.WorkspaceClient, c.Serverless,
+	}
+}
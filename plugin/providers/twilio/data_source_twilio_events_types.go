@@ -0,0 +1,142 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// eventsType is an event type that can be subscribed to. For more documentation, see
+// https://www.twilio.com/docs/events/event-types
+type eventsType struct {
+	Type        string `json:"type"`
+	SchemaID    string `json:"schema_id"`
+	Description string `json:"description"`
+}
+
+type eventsTypePage struct {
+	Types []*eventsType  `json:"types"`
+	Meta  twiclient.Meta `json:"meta"`
+}
+
+func (p *eventsTypePage) nextPage() string {
+	return p.Meta.NextPageURL.String
+}
+
+// dataSourceTwilioEventsTypes lists the event types available to Event Streams, e.g. to check the types of a
+// `twilio_events_subscription` against.
+func dataSourceTwilioEventsTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTwilioEventsTypesRead,
+		Schema: map[string]*schema.Schema{
+			// Only lists the event types of a schema, e.g. `Messaging.MessageStatus`.
+			"schema_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// listEventsTypes lists the event types available to subscriptions, only those of schemaID if it is set.
+func listEventsTypes(context context.Context, client *twiclient.Client, schemaID string) ([]*eventsType, error) {
+	params := url.Values{"PageSize": []string{"1000"}}
+	if schemaID != "" {
+		params.Add("SchemaId", schemaID)
+	}
+
+	var types []*eventsType
+	err := listAllPages(context, client, "Types", params,
+		func() listPage {
+			return new(eventsTypePage)
+		},
+		func(page listPage) {
+			types = append(types, page.(*eventsTypePage).Types...)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func dataSourceTwilioEventsTypesRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataSourceTwilioEventsTypesRead")
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Events.Types.List")
+
+	eventsTypes, err := listEventsTypes(context, client, d.Get("schema_id").(string))
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Events.Types.List failed")
+
+		return fmt.Errorf("Failed to list event types: %s", err.Error())
+	}
+
+	sort.Slice(eventsTypes, func(i, j int) bool {
+		return eventsTypes[i].Type < eventsTypes[j].Type
+	})
+
+	types := make([]interface{}, 0, len(eventsTypes))
+	names := make([]string, 0, len(eventsTypes))
+	for _, eventType := range eventsTypes {
+		types = append(types, map[string]interface{}{
+			"type":        eventType.Type,
+			"schema_id":   eventType.SchemaID,
+			"description": eventType.Description,
+		})
+		names = append(names, eventType.Type)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(names, ","))))
+	d.Set("types", types)
+	d.Set("names", names)
+	return nil
+}
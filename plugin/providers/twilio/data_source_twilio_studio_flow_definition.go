@@ -0,0 +1,318 @@
+package twilio
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	log "github.com/sirupsen/logrus"
+)
+
+// studioWidgetEvents lists the Studio widget types the definition builder knows about, together with the transition
+// events each of them can fire. See https://www.twilio.com/docs/studio/widget-library
+var studioWidgetEvents = map[string][]string{
+	"trigger":                 []string{"incomingMessage", "incomingCall", "incomingConversationMessage", "incomingRequest", "incomingParent"},
+	"send-message":            []string{"sent", "failed"},
+	"send-and-wait-for-reply": []string{"incomingMessage", "timeout", "deliveryFailure"},
+	"gather-input-on-call":    []string{"keypress", "speech", "timeout"},
+	"split-based-on":          []string{"noMatch", "match"},
+	"set-variables":           []string{"next"},
+	"make-http-request":       []string{"success", "failed"},
+	"run-function":            []string{"success", "fail"},
+	"run-subflow":             []string{"completed", "failed"},
+	"send-to-flex":            []string{"callComplete", "failedToEnqueue", "callFailure"},
+	"say-play":                []string{"audioComplete"},
+	"connect-call-to":         []string{"callCompleted", "hangup"},
+	"enqueue-call":            []string{"callComplete", "failedToEnqueue", "callFailure"},
+	"record-voicemail":        []string{"recordingComplete", "noAudio", "hangup"},
+	"record-call":             []string{"success", "failed"},
+	"make-outgoing-call-v2":   []string{"answered", "busy", "noAnswer", "failed"},
+	"capture-payments":        []string{"success", "maxFailedAttempts", "providerError", "payInterrupted", "hangup", "validationError"},
+	"add-twiml-redirect":      []string{"return", "timeout", "fail"},
+}
+
+// studioFlowDocument is the JSON representation of a Studio Flow definition.
+type studioFlowDocument struct {
+	Description  string             `json:"description"`
+	States       []*studioFlowState `json:"states"`
+	InitialState string             `json:"initial_state"`
+	Flags        studioFlowDocFlags `json:"flags"`
+}
+
+type studioFlowDocFlags struct {
+	AllowConcurrentCalls bool `json:"allow_concurrent_calls"`
+}
+
+type studioFlowState struct {
+	Name        string                  `json:"name"`
+	Type        string                  `json:"type"`
+	Transitions []*studioFlowTransition `json:"transitions"`
+	Properties  map[string]interface{}  `json:"properties"`
+}
+
+type studioFlowTransition struct {
+	Event      string                 `json:"event"`
+	Next       string                 `json:"next,omitempty"`
+	Conditions []*studioFlowCondition `json:"conditions,omitempty"`
+}
+
+type studioFlowCondition struct {
+	FriendlyName string   `json:"friendly_name"`
+	Arguments    []string `json:"arguments"`
+	Type         string   `json:"type"`
+	Value        string   `json:"value"`
+}
+
+func dataSourceTwilioStudioFlowDefinition() *schema.Resource {
+	widgetTypes := make([]string, 0, len(studioWidgetEvents))
+	for widgetType := range studioWidgetEvents {
+		widgetTypes = append(widgetTypes, widgetType)
+	}
+	sort.Strings(widgetTypes)
+
+	return &schema.Resource{
+		Read: dataSourceTwilioStudioFlowDefinitionRead,
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"initial_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Trigger",
+			},
+			"allow_concurrent_calls": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(widgetTypes, false),
+						},
+						"offset_x": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"offset_y": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"properties": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"properties_json": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: jsonattr.ValidateFunc,
+						},
+						"transition": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"next": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"condition": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"friendly_name": &schema.Schema{
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"arguments": &schema.Schema{
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"type": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"json": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandStudioFlowState(raw map[string]interface{}) (*studioFlowState, error) {
+	state := &studioFlowState{
+		Name:        raw["name"].(string),
+		Type:        raw["type"].(string),
+		Transitions: []*studioFlowTransition{},
+		Properties:  make(map[string]interface{}),
+	}
+
+	for key, value := range raw["properties"].(map[string]interface{}) {
+		state.Properties[key] = value
+	}
+
+	if propertiesJSON := raw["properties_json"].(string); propertiesJSON != "" {
+		var properties map[string]interface{}
+		if err := json.Unmarshal([]byte(propertiesJSON), &properties); err != nil {
+			return nil, fmt.Errorf("State %q has invalid properties_json: %s", state.Name, err)
+		}
+
+		for key, value := range properties {
+			state.Properties[key] = value
+		}
+	}
+
+	// An offset given in properties_json is kept unless offset_x or offset_y is set.
+	offsetX, offsetY := raw["offset_x"].(int), raw["offset_y"].(int)
+	if _, ok := state.Properties["offset"]; !ok || offsetX != 0 || offsetY != 0 {
+		state.Properties["offset"] = map[string]interface{}{
+			"x": offsetX,
+			"y": offsetY,
+		}
+	}
+
+	for _, rawTransition := range raw["transition"].([]interface{}) {
+		t := rawTransition.(map[string]interface{})
+		transition := &studioFlowTransition{
+			Event: t["event"].(string),
+			Next:  t["next"].(string),
+		}
+
+		for _, rawCondition := range t["condition"].([]interface{}) {
+			c := rawCondition.(map[string]interface{})
+			condition := &studioFlowCondition{
+				FriendlyName: c["friendly_name"].(string),
+				Type:         c["type"].(string),
+				Value:        c["value"].(string),
+			}
+			for _, argument := range c["arguments"].([]interface{}) {
+				condition.Arguments = append(condition.Arguments, argument.(string))
+			}
+			transition.Conditions = append(transition.Conditions, condition)
+		}
+
+		state.Transitions = append(state.Transitions, transition)
+	}
+
+	return state, nil
+}
+
+// validateStudioFlowDocument ensures the state graph is consistent: state names are unique, every transition uses an
+// event the widget can fire and every `next` refers to a state defined in the document.
+func validateStudioFlowDocument(doc *studioFlowDocument) error {
+	states := make(map[string]bool)
+	for _, state := range doc.States {
+		if states[state.Name] {
+			return fmt.Errorf("State %q is defined more than once", state.Name)
+		}
+		states[state.Name] = true
+	}
+
+	if !states[doc.InitialState] {
+		return fmt.Errorf("initial_state %q does not refer to a defined state", doc.InitialState)
+	}
+
+	for _, state := range doc.States {
+		events := studioWidgetEvents[state.Type]
+
+		for _, transition := range state.Transitions {
+			if !stringInSlice(transition.Event, events) {
+				return fmt.Errorf("State %q (%s) has a transition on unsupported event %q, expected one of: %s",
+					state.Name, state.Type, transition.Event, strings.Join(events, ", "))
+			}
+
+			if transition.Next != "" && !states[transition.Next] {
+				return fmt.Errorf("State %q transitions on %q to undefined state %q", state.Name, transition.Event, transition.Next)
+			}
+		}
+	}
+
+	return nil
+}
+
+func stringInSlice(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func dataSourceTwilioStudioFlowDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataSourceTwilioStudioFlowDefinitionRead")
+
+	doc := &studioFlowDocument{
+		Description:  d.Get("description").(string),
+		InitialState: d.Get("initial_state").(string),
+		States:       []*studioFlowState{},
+		Flags: studioFlowDocFlags{
+			AllowConcurrentCalls: d.Get("allow_concurrent_calls").(bool),
+		},
+	}
+
+	for _, rawState := range d.Get("state").([]interface{}) {
+		state, err := expandStudioFlowState(rawState.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		doc.States = append(doc.States, state)
+	}
+
+	if err := validateStudioFlowDocument(doc); err != nil {
+		return err
+	}
+
+	definition, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Failed to render studio flow definition: %s", err.Error())
+	}
+
+	d.Set("json", string(definition))
+	d.SetId(strconv.Itoa(hashcode.String(string(definition))))
+
+	return nil
+}
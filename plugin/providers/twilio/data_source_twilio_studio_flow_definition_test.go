@@ -0,0 +1,101 @@
+package twilio
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// studioFlowTestState returns the raw `state` block expandStudioFlowState reads, with every attribute at its zero value.
+func studioFlowTestState(name string, widgetType string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            name,
+		"type":            widgetType,
+		"offset_x":        0,
+		"offset_y":        0,
+		"properties":      map[string]interface{}{},
+		"properties_json": "",
+		"transition":      []interface{}{},
+	}
+}
+
+func studioFlowTestDocument(states ...*studioFlowState) *studioFlowDocument {
+	return &studioFlowDocument{
+		InitialState: "Trigger",
+		States:       states,
+	}
+}
+
+func studioFlowTestTransition(event string, next string) []*studioFlowTransition {
+	return []*studioFlowTransition{&studioFlowTransition{Event: event, Next: next}}
+}
+
+var _ = Describe("Studio Flow definition", func() {
+
+	Describe("validateStudioFlowDocument", func() {
+
+		table.DescribeTable("validating the state graph",
+			func(doc *studioFlowDocument, expectedError string) {
+				err := validateStudioFlowDocument(doc)
+				if expectedError == "" {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(MatchError(ContainSubstring(expectedError)))
+				}
+			},
+			table.Entry("a consistent graph", studioFlowTestDocument(
+				&studioFlowState{Name: "Trigger", Type: "trigger", Transitions: studioFlowTestTransition("incomingMessage", "Reply")},
+				&studioFlowState{Name: "Reply", Type: "send-message", Transitions: studioFlowTestTransition("sent", "")},
+			), ""),
+			table.Entry("duplicate states", studioFlowTestDocument(
+				&studioFlowState{Name: "Trigger", Type: "trigger"},
+				&studioFlowState{Name: "Trigger", Type: "send-message"},
+			), `State "Trigger" is defined more than once`),
+			table.Entry("a missing initial state", studioFlowTestDocument(
+				&studioFlowState{Name: "Start", Type: "trigger"},
+			), `initial_state "Trigger" does not refer to a defined state`),
+			table.Entry("an unknown next state", studioFlowTestDocument(
+				&studioFlowState{Name: "Trigger", Type: "trigger", Transitions: studioFlowTestTransition("incomingCall", "Greet")},
+			), `to undefined state "Greet"`),
+			table.Entry("an event the widget can't fire", studioFlowTestDocument(
+				&studioFlowState{Name: "Trigger", Type: "trigger", Transitions: studioFlowTestTransition("incomingMessage", "Reply")},
+				&studioFlowState{Name: "Reply", Type: "send-message", Transitions: studioFlowTestTransition("timeout", "")},
+			), `unsupported event "timeout"`),
+		)
+	})
+
+	Describe("expandStudioFlowState", func() {
+
+		table.DescribeTable("setting the widget offset",
+			func(offsetX int, offsetY int, propertiesJSON string, expected map[string]interface{}) {
+				raw := studioFlowTestState("Trigger", "trigger")
+				raw["offset_x"] = offsetX
+				raw["offset_y"] = offsetY
+				raw["properties_json"] = propertiesJSON
+
+				state, err := expandStudioFlowState(raw)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(state.Properties["offset"]).To(Equal(expected))
+			},
+			table.Entry("defaults to the origin", 0, 0, "",
+				map[string]interface{}{"x": 0, "y": 0}),
+			table.Entry("uses offset_x and offset_y", 250, -40, "",
+				map[string]interface{}{"x": 250, "y": -40}),
+			table.Entry("keeps an offset from properties_json", 0, 0, `{"offset": {"x": 10, "y": 20}}`,
+				map[string]interface{}{"x": float64(10), "y": float64(20)}),
+			table.Entry("prefers offset_x and offset_y over properties_json", 5, 0, `{"offset": {"x": 10, "y": 20}}`,
+				map[string]interface{}{"x": 5, "y": 0}),
+		)
+
+		It("should merge properties and properties_json", func() {
+			raw := studioFlowTestState("Reply", "send-message")
+			raw["properties"] = map[string]interface{}{"body": "Woomy!"}
+			raw["properties_json"] = `{"to": "{{contact.channel.address}}"}`
+
+			state, err := expandStudioFlowState(raw)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state.Properties).To(HaveKeyWithValue("body", "Woomy!"))
+			Expect(state.Properties).To(HaveKeyWithValue("to", "{{contact.channel.address}}"))
+		})
+	})
+})
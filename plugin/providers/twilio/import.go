@@ -0,0 +1,56 @@
+package twilio
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// importStateWithParentSid imports resources that are nested under a parent resource (e.g. a sender belonging to a
+// Messaging Service). The import ID is expected to be of the format `<parent sid>/<sid>`; the parent SID is stored
+// under the given attribute and the resource ID becomes the child SID. Resources nested several levels deep list
+// their parents outermost first, as in `<grandparent sid>/<parent sid>/<sid>`.
+func importStateWithParentSid(parentAttributes ...string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+
+		valid := len(parts) == len(parentAttributes)+1
+		for _, part := range parts {
+			valid = valid && part != ""
+		}
+		if !valid {
+			return nil, fmt.Errorf("Unexpected import ID %q, expected <%s>/<sid>", d.Id(), strings.Join(parentAttributes, ">/<"))
+		}
+
+		for i, parentAttribute := range parentAttributes {
+			d.Set(parentAttribute, parts[i])
+		}
+		d.SetId(parts[len(parts)-1])
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// importSingletonState imports configuration resources that belong to a parent resource and are identified by it
+// alone (e.g. a Conversations Service's configuration). The import ID is the parent SID, or `<grandparent sid>/<parent
+// sid>` for resources nested deeper, and is kept as the resource ID.
+func importSingletonState(parentAttributes ...string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+
+		valid := len(parts) == len(parentAttributes)
+		for _, part := range parts {
+			valid = valid && part != ""
+		}
+		if !valid {
+			return nil, fmt.Errorf("Unexpected import ID %q, expected <%s>", d.Id(), strings.Join(parentAttributes, ">/<"))
+		}
+
+		for i, parentAttribute := range parentAttributes {
+			d.Set(parentAttribute, parts[i])
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
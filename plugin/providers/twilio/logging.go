@@ -0,0 +1,169 @@
+package twilio
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ConfigureLogging routes the provider's logs through Terraform: every line is prefixed with its level in the
+// "[DEBUG] ..." form Terraform filters on, the level follows TF_LOG, and secrets are redacted.
+func ConfigureLogging() {
+	log.SetOutput(os.Stderr)
+	log.SetLevel(logLevelFromEnv(os.Getenv("TF_LOG")))
+	log.SetFormatter(&terraformLogFormatter{redactor: logRedactor})
+}
+
+// logLevelFromEnv maps a TF_LOG value onto a logrus level. Terraform discards plugin output when TF_LOG isn't set, so
+// only warnings and errors are formatted then. Like Terraform, any other value it doesn't recognise means TRACE.
+func logLevelFromEnv(value string) log.Level {
+	switch strings.ToUpper(value) {
+	case "":
+		return log.WarnLevel
+	case "DEBUG":
+		return log.DebugLevel
+	case "INFO":
+		return log.InfoLevel
+	case "WARN":
+		return log.WarnLevel
+	case "ERROR":
+		return log.ErrorLevel
+	}
+	return log.TraceLevel
+}
+
+// terraformLogFormatter writes entries as "[LEVEL] message key=value ...", which is what Terraform expects from
+// plugins, and redacts each line before it is written.
+type terraformLogFormatter struct {
+	redactor *redactor
+}
+
+func (f *terraformLogFormatter) Format(entry *log.Entry) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "[%s] %s", terraformLogLevel(entry.Level), entry.Message)
+
+	keys := make([]string, 0, len(entry.Data))
+	for key := range entry.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(buf, " %s=%v", key, entry.Data[key])
+	}
+
+	return []byte(f.redactor.redact(buf.String()) + "\n"), nil
+}
+
+func terraformLogLevel(level log.Level) string {
+	switch level {
+	case log.TraceLevel:
+		return "TRACE"
+	case log.DebugLevel:
+		return "DEBUG"
+	case log.InfoLevel:
+		return "INFO"
+	case log.WarnLevel:
+		return "WARN"
+	}
+	return "ERROR"
+}
+
+const redacted = "[REDACTED]"
+
+var (
+	// Authorization headers as dumped by the HTTP tracing.
+	authorizationHeaderPattern = regexp.MustCompile(`(?i)(authorization:\s*\w+\s+)\S+`)
+	// Secret attributes in JSON responses (e.g. a new API key's secret or a subaccount's auth token)...
+	secretJSONPattern = regexp.MustCompile(`(?i)("(?:auth_token|secret|password|private_key|api_key|api_secret|certificate)"\s*:\s*")[^"]*(")`)
+	// ...and in form-encoded request bodies (e.g. a push credential's private key, API key or certificate).
+	secretFormPattern = regexp.MustCompile(`(?i)\b((?:AuthToken|Secret|Password|PrivateKey|ApiKey|ApiSecret|Certificate)=)[^&\s]*`)
+	// E.164 phone numbers, optionally URL-encoded. Everything but the last four digits is masked.
+	phoneNumberPattern = regexp.MustCompile(`(\+|%2B)[1-9]\d{3,10}(\d{4})\b`)
+)
+
+// redactor scrubs credentials, and optionally phone numbers, from log output.
+type redactor struct {
+	mu                 sync.RWMutex
+	secrets            []string
+	redactPhoneNumbers bool
+}
+
+// logRedactor is shared by the log formatter and the provider configuration, which registers the configured secrets.
+var logRedactor = &redactor{redactPhoneNumbers: true}
+
+func (r *redactor) addSecret(secret string) {
+	if secret == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.secrets = append(r.secrets, secret)
+}
+
+func (r *redactor) setRedactPhoneNumbers(redactPhoneNumbers bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.redactPhoneNumbers = redactPhoneNumbers
+}
+
+func (r *redactor) redact(line string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, secret := range r.secrets {
+		line = strings.Replace(line, secret, redacted, -1)
+	}
+
+	line = authorizationHeaderPattern.ReplaceAllString(line, "${1}"+redacted)
+	line = secretJSONPattern.ReplaceAllString(line, "${1}"+redacted+"${2}")
+	line = secretFormPattern.ReplaceAllString(line, "${1}"+redacted)
+
+	if r.redactPhoneNumbers {
+		line = phoneNumberPattern.ReplaceAllString(line, "${1}******${2}")
+	}
+
+	return line
+}
+
+// loggingTransport traces HTTP requests and responses at TRACE level. The dumps go through the log formatter, so they
+// are redacted like any other line.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func newLoggingTransport(transport http.RoundTripper, enabled bool) http.RoundTripper {
+	if !enabled {
+		return transport
+	}
+	return &loggingTransport{transport: transport}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		log.Trace("HTTP request:\n" + string(dump))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		log.WithError(err).Trace("HTTP request failed")
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpResponse(resp, true); err == nil {
+		log.Trace("HTTP response:\n" + string(dump))
+	}
+
+	return resp, nil
+}
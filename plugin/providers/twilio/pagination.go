@@ -0,0 +1,38 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// listPage is a page of a Twilio list response. Account API pages embed twiclient.Page and link to the next page with
+// `next_page_uri`, while the newer APIs nest the link in twiclient.Meta as `next_page_url`.
+type listPage interface {
+	// nextPage returns the link to the next page, or "" on the last page.
+	nextPage() string
+}
+
+// listAllPages lists the collection at pathPart, following the link to the next page until the last, and hands each
+// page to add.
+func listAllPages(context context.Context, client *twiclient.Client, pathPart string, data url.Values, newPage func() listPage, add func(listPage)) error {
+	page := newPage()
+	if err := client.ListResource(context, pathPart, data, page); err != nil {
+		return err
+	}
+
+	for {
+		add(page)
+
+		next := page.nextPage()
+		if next == "" {
+			return nil
+		}
+
+		page = newPage()
+		if err := client.GetNextPage(context, next, page); err != nil {
+			return err
+		}
+	}
+}
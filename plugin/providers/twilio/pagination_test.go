@@ -0,0 +1,45 @@
+package twilio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	twiclient "github.com/kaiquelupo/twilio-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	It("should follow the next page links until the last page", func() {
+		pages := map[string]string{
+			"": `{"ip_addresses": [{"sid": "IP1", "ip_address": "10.0.0.1", "cidr_prefix_length": 32}],
+				"next_page_uri": "/2010-04-01/Accounts/AC123/SIP/IpAccessControlLists/AL123/IpAddresses.json?PageSize=1000&Page=1&PageToken=PA1"}`,
+			"PA1": `{"ip_addresses": [{"sid": "IP2", "ip_address": "10.0.0.2", "cidr_prefix_length": 32}], "next_page_uri": null}`,
+		}
+
+		var requests []string
+		client := twiclient.NewClient("AC123", "token", &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				requests = append(requests, req.URL.RequestURI())
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       ioutil.NopCloser(strings.NewReader(pages[req.URL.Query().Get("PageToken")])),
+					Request:    req,
+				}, nil
+			}),
+		})
+
+		ipAddresses, err := listSipIPAddresses(context.Background(), client, "AL123")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipAddresses).To(HaveLen(2))
+		Expect(ipAddresses).To(HaveKey("10.0.0.1/32"))
+		Expect(ipAddresses).To(HaveKey("10.0.0.2/32"))
+		Expect(requests).To(Equal([]string{
+			"/2010-04-01/Accounts/AC123/SIP/IpAccessControlLists/AL123/IpAddresses.json?PageSize=1000",
+			"/2010-04-01/Accounts/AC123/SIP/IpAccessControlLists/AL123/IpAddresses.json?PageSize=1000&Page=1&PageToken=PA1",
+		}))
+	})
+})
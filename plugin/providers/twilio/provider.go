@@ -1,7 +1,10 @@
 package twilio
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -9,12 +12,13 @@ var descriptions map[string]string
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema:         providerSchema(),
 		DataSourcesMap: providerDataSourcesMap(),
 		ResourcesMap:   providerResources(),
-		ConfigureFunc:  providerConfigure,
 	}
+	provider.ConfigureFunc = providerConfigure(provider)
+	return provider
 }
 
 // List of supported configuration fields for your provider.
@@ -22,13 +26,86 @@ func providerSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_sid": &schema.Schema{
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The unique ID that identifies your Twilio account. Starts with `AC` and can be found on the Settings -> General page (https://www.twilio.com/console/project/settings).",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("TWILIO_ACCOUNT_SID", ""),
+			Description: "The unique ID that identifies your Twilio account. Starts with `AC` and can be found on the Settings -> General page (https://www.twilio.com/console/project/settings). Defaults to `TWILIO_ACCOUNT_SID`.",
 		},
 		"auth_token": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("TWILIO_AUTH_TOKEN", ""),
+			ConflictsWith: []string{"api_key", "api_secret"},
+			Description:   "Your secret token to access your Twilio account. Keep this safe - DO NOT check this into source control! Either `auth_token` or `api_key` and `api_secret` must be set. Defaults to `TWILIO_AUTH_TOKEN`.",
+		},
+		"api_key": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("TWILIO_API_KEY", ""),
+			Description: "The SID of an API key (starts with `SK`) to authenticate with instead of the auth token. Defaults to `TWILIO_API_KEY`.",
+		},
+		"api_secret": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc("TWILIO_API_SECRET", ""),
+			Description: "The secret of the API key given in `api_key`. Keep this safe - DO NOT check this into source control! Defaults to `TWILIO_API_SECRET`.",
+		},
+		"profile": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of a Twilio CLI profile in `~/.twilio-cli/config.json` to read the account SID, API key, and region from. Settings configured on the provider or in the environment take precedence.",
+		},
+		"region": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("TWILIO_REGION", ""),
+			Description: "The Twilio Region to send requests to, e.g. `au1` or `ie1`. Defaults to `TWILIO_REGION`.",
+		},
+		"edge": &schema.Schema{
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Your secret token to access your Twilio account. Keep this safe - DO NOT check this into source control!",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("TWILIO_EDGE", ""),
+			Description: "The Twilio Edge Location to connect through, e.g. `sydney` or `dublin`. Defaults to `TWILIO_EDGE`.",
+		},
+		"subaccount_sid": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"api_key"},
+			Description:   "The SID of a subaccount (starts with `AC`) to manage resources in, using the parent account's `auth_token`. Use an aliased provider to manage several subaccounts.",
+		},
+		"max_retries": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultMaxRetries,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "How many times to retry a request Twilio rejected with 429 Too Many Requests, or an idempotent request that failed with a 5xx. Retries back off exponentially and honor `Retry-After`.",
+		},
+		"requests_per_second": &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validateNonNegativeFloat,
+			Description:  "The maximum number of requests per second this provider sends to Twilio, across all resources. `0` disables rate limiting.",
+		},
+		"product_requests_per_second": &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeFloat,
+			},
+			Description: "Rate limits for individual products, keyed by the first label of the API hostname (e.g. `api`, `taskrouter`, `serverless`, `messaging`). These replace `requests_per_second` for that product.",
+		},
+		"log_http_traffic": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("DEBUG_HTTP_TRAFFIC", false),
+			Description: "Whether to log every HTTP request and response at TRACE level. Credentials are redacted. Defaults to `DEBUG_HTTP_TRAFFIC`.",
+		},
+		"redact_phone_numbers": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to mask all but the last four digits of phone numbers in the provider's logs.",
 		},
 		"endpoint": &schema.Schema{
 			Type:        schema.TypeString,
@@ -50,19 +127,116 @@ func providerResources() map[string]*schema.Resource {
 		"twilio_phoneNumber": resourceTwilioPhoneNumber(),
 		"twilio_workspace":   resourceTwilioWorkspace(),
 		"twilio_serverless_service": resourceTwilioServerlessService(),
+		"twilio_messaging_service":              resourceTwilioMessagingService(),
+		"twilio_messaging_service_phone_number": resourceTwilioMessagingServicePhoneNumber(),
+		"twilio_messaging_service_short_code":   resourceTwilioMessagingServiceShortCode(),
+		"twilio_messaging_service_alpha_sender": resourceTwilioMessagingServiceAlphaSender(),
+		"twilio_studio_flow":                    resourceTwilioStudioFlow(),
+		"twilio_sip_trunk":                      resourceTwilioSipTrunk(),
+		"twilio_sip_trunk_origination_url":      resourceTwilioSipTrunkOriginationURL(),
+		"twilio_sip_trunk_ip_access_control_list": resourceTwilioSipTrunkIPAccessControlList(),
+		"twilio_sip_trunk_credential_list":      resourceTwilioSipTrunkCredentialList(),
+		"twilio_sip_trunk_phone_number":         resourceTwilioSipTrunkPhoneNumber(),
+		"twilio_sip_domain":                     resourceTwilioSipDomain(),
+		"twilio_sip_credential_list":            resourceTwilioSipCredentialList(),
+		"twilio_sip_ip_access_control_list":     resourceTwilioSipIPAccessControlList(),
+		"twilio_sip_domain_credential_list_mapping":              resourceTwilioSipDomainCredentialListMapping(),
+		"twilio_sip_domain_ip_access_control_list_mapping":       resourceTwilioSipDomainIPAccessControlListMapping(),
+		"twilio_sip_domain_registration_credential_list_mapping": resourceTwilioSipDomainRegistrationCredentialListMapping(),
+		"twilio_api_key":     resourceTwilioAPIKey(),
+		"twilio_signing_key": resourceTwilioSigningKey(),
+		"twilio_conversations_service":               resourceTwilioConversationsService(),
+		"twilio_conversations_service_configuration": resourceTwilioConversationsServiceConfiguration(),
+		"twilio_conversations_role":                  resourceTwilioConversationsRole(),
+		"twilio_conversations_webhook":               resourceTwilioConversationsWebhook(),
+		"twilio_conversations_address_configuration": resourceTwilioConversationsAddressConfiguration(),
+		"twilio_sync_service":                        resourceTwilioSyncService(),
+		"twilio_sync_document":                       resourceTwilioSyncDocument(),
+		"twilio_sync_map":                            resourceTwilioSyncMap(),
+		"twilio_sync_map_item":                       resourceTwilioSyncMapItem(),
+		"twilio_sync_list":                           resourceTwilioSyncList(),
+		"twilio_sync_list_item":                      resourceTwilioSyncListItem(),
+		"twilio_verify_service":                      resourceTwilioVerifyService(),
+		"twilio_verify_rate_limit":                   resourceTwilioVerifyRateLimit(),
+		"twilio_verify_rate_limit_bucket":            resourceTwilioVerifyRateLimitBucket(),
+		"twilio_verify_messaging_configuration":      resourceTwilioVerifyMessagingConfiguration(),
+		"twilio_proxy_service":                       resourceTwilioProxyService(),
+		"twilio_proxy_phone_number":                  resourceTwilioProxyPhoneNumber(),
+		"twilio_proxy_short_code":                    resourceTwilioProxyShortCode(),
+		"twilio_notify_credential":                   resourceTwilioNotifyCredential(),
+		"twilio_notify_service":                      resourceTwilioNotifyService(),
+		"twilio_events_sink":                         resourceTwilioEventsSink(),
+		"twilio_events_subscription":                 resourceTwilioEventsSubscription(),
 	}
 }
 
 // List of supported data sources and their configuration fields.
 func providerDataSourcesMap() map[string]*schema.Resource {
-	return map[string]*schema.Resource{}
+	return map[string]*schema.Resource{
+		"twilio_studio_flow_definition": dataSourceTwilioStudioFlowDefinition(),
+		"twilio_events_types":           dataSourceTwilioEventsTypes(),
+	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(provider *schema.Provider) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
+		meta, err := configureTwilioContext(d)
+		if err != nil {
+			return nil, err
+		}
+
+		// Resource operations derive their contexts from the provider's stop context, so that interrupting Terraform
+		// cancels in-flight requests.
+		meta.stopContext = provider.StopContext()
+		return meta, nil
+	}
+}
+
+func configureTwilioContext(d *schema.ResourceData) (*TerraformTwilioContext, error) {
 	config := Config{
-		AccountSID: d.Get("account_sid").(string),
-		AuthToken:  d.Get("auth_token").(string),
-		Endpoint:   d.Get("endpoint").(string),
+		AccountSID:    d.Get("account_sid").(string),
+		AuthToken:     d.Get("auth_token").(string),
+		APIKey:        d.Get("api_key").(string),
+		APISecret:     d.Get("api_secret").(string),
+		SubaccountSID: d.Get("subaccount_sid").(string),
+		Region:        d.Get("region").(string),
+		Edge:          d.Get("edge").(string),
+		MaxRetries:    d.Get("max_retries").(int),
+		Endpoint:      d.Get("endpoint").(string),
+
+		RequestsPerSecond:        d.Get("requests_per_second").(float64),
+		ProductRequestsPerSecond: make(map[string]float64),
+
+		LogHTTPTraffic:     d.Get("log_http_traffic").(bool),
+		RedactPhoneNumbers: d.Get("redact_phone_numbers").(bool),
+	}
+
+	for product, rate := range d.Get("product_requests_per_second").(map[string]interface{}) {
+		config.ProductRequestsPerSecond[product] = rate.(float64)
+	}
+
+	if profile := d.Get("profile").(string); profile != "" {
+		if err := loadTwilioCLIProfile(&config, profile); err != nil {
+			return nil, err
+		}
 	}
+
+	if config.AccountSID == "" {
+		return nil, fmt.Errorf("account_sid must be configured, either directly, with TWILIO_ACCOUNT_SID or through a Twilio CLI profile")
+	}
+
+	if config.AuthToken == "" && (config.APIKey == "" || config.APISecret == "") {
+		return nil, fmt.Errorf("Either auth_token or both api_key and api_secret must be configured")
+	}
+
+	// ConflictsWith only sees the provider block, while the key may also come from TWILIO_API_KEY or a profile.
+	if config.SubaccountSID != "" && config.APIKey != "" {
+		return nil, fmt.Errorf("subaccount_sid can't be used with an API key, which may also be set with TWILIO_API_KEY or through a Twilio CLI profile; use the parent account's auth_token instead")
+	}
+
+	logRedactor.addSecret(config.AuthToken)
+	logRedactor.addSecret(config.APISecret)
+	logRedactor.setRedactPhoneNumbers(config.RedactPhoneNumbers)
+
 	return config.Client()
 }
@@ -0,0 +1,109 @@
+package twilio
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenBucket allows up to `rate` requests per second on average, with bursts of up to one second's worth.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before it may be used.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport holds requests back so that a provider instance stays within the configured request rate. Every
+// product (the first label of the API hostname, e.g. "api" or "taskrouter") shares the default bucket unless it has a
+// bucket of its own.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *tokenBucket
+	products  map[string]*tokenBucket
+}
+
+func newRateLimitTransport(transport http.RoundTripper, requestsPerSecond float64, productRequestsPerSecond map[string]float64) http.RoundTripper {
+	if requestsPerSecond <= 0 && len(productRequestsPerSecond) == 0 {
+		return transport
+	}
+
+	t := &rateLimitTransport{
+		transport: transport,
+		products:  make(map[string]*tokenBucket),
+	}
+	if requestsPerSecond > 0 {
+		t.limiter = newTokenBucket(requestsPerSecond)
+	}
+	for product, rate := range productRequestsPerSecond {
+		if rate > 0 {
+			t.products[product] = newTokenBucket(rate)
+		}
+	}
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := t.limiterFor(req.URL.Hostname()); limiter != nil {
+		if err := limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.transport.RoundTrip(req)
+}
+
+func (t *rateLimitTransport) limiterFor(host string) *tokenBucket {
+	product := strings.SplitN(host, ".", 2)[0]
+	if limiter, ok := t.products[product]; ok {
+		return limiter
+	}
+	return t.limiter
+}
@@ -0,0 +1,91 @@
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tokenBucket", func() {
+	It("allows a burst of one second's worth of requests", func() {
+		bucket := newTokenBucket(5)
+
+		for i := 0; i < 5; i++ {
+			Expect(bucket.reserve()).To(BeZero(), "request %d", i)
+		}
+		Expect(bucket.reserve()).To(BeNumerically("~", 200*time.Millisecond, 10*time.Millisecond))
+	})
+
+	It("allows a burst of one request below one request per second", func() {
+		bucket := newTokenBucket(0.5)
+
+		Expect(bucket.reserve()).To(BeZero())
+		Expect(bucket.reserve()).To(BeNumerically("~", 2*time.Second, 10*time.Millisecond))
+	})
+
+	It("refills at the configured rate, up to the burst", func() {
+		bucket := newTokenBucket(10)
+		for i := 0; i < 10; i++ {
+			bucket.reserve()
+		}
+
+		bucket.last = bucket.last.Add(-500 * time.Millisecond)
+		for i := 0; i < 5; i++ {
+			Expect(bucket.reserve()).To(BeZero(), "request %d", i)
+		}
+		Expect(bucket.reserve()).NotTo(BeZero())
+
+		bucket.last = bucket.last.Add(-time.Hour)
+		for i := 0; i < 10; i++ {
+			Expect(bucket.reserve()).To(BeZero(), "request %d", i)
+		}
+		Expect(bucket.reserve()).NotTo(BeZero())
+	})
+
+	It("stops waiting when the context is done", func() {
+		bucket := newTokenBucket(0.01)
+		bucket.reserve()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(bucket.wait(ctx)).To(MatchError(context.Canceled))
+	})
+})
+
+var _ = Describe("rateLimitTransport", func() {
+	It("isn't used without a rate", func() {
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, nil
+		})
+
+		Expect(newRateLimitTransport(transport, 0, nil)).To(BeAssignableToTypeOf(transport))
+	})
+
+	table.DescribeTable("picks the bucket from the host",
+		func(requestsPerSecond float64, host string, expected string) {
+			transport := newRateLimitTransport(http.DefaultTransport, requestsPerSecond, map[string]float64{
+				"taskrouter": 2,
+				"studio":     0,
+			}).(*rateLimitTransport)
+
+			buckets := map[string]*tokenBucket{
+				"default":    transport.limiter,
+				"taskrouter": transport.products["taskrouter"],
+			}
+			if expected == "" {
+				Expect(transport.limiterFor(host)).To(BeNil())
+			} else {
+				Expect(transport.limiterFor(host)).To(BeIdenticalTo(buckets[expected]))
+			}
+		},
+		table.Entry("a product with its own bucket", 10.0, "taskrouter.twilio.com", "taskrouter"),
+		table.Entry("a product with its own bucket in a region", 10.0, "taskrouter.dublin.ie1.twilio.com", "taskrouter"),
+		table.Entry("another product", 10.0, "api.twilio.com", "default"),
+		table.Entry("a product whose rate isn't positive", 10.0, "studio.twilio.com", "default"),
+		table.Entry("another product without a default rate", 0.0, "api.twilio.com", ""),
+	)
+})
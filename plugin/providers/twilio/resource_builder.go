@@ -0,0 +1,391 @@
+package twilio
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	"github.com/kevinburke/rest"
+	log "github.com/sirupsen/logrus"
+)
+
+// twilioResource declares a resource backed by a plain Twilio REST collection, or by a configuration singleton, from
+// which build generates the CRUD functions, import and timeouts.
+//
+// The model is the API response struct, and the resource's schema is derived from it with mapper.SchemaFromStruct.
+// Fields tagged `terraform:"attribute"` are written to state after every call (the attribute named by idAttribute is
+// required, except on singletons), and fields that are also tagged `form:"Param"` are sent on create and update when the attribute is
+// set or has changed. Optional parameters should be pointers, so that clearing them is sent too.
+type twilioResource struct {
+	// name identifies the API in log messages, e.g. "TaskRouter.Workspace.Workers".
+	name string
+	// description is used in error messages, e.g. "worker".
+	description string
+	// client picks the API client from the provider context.
+	client func(*TerraformTwilioContext) *twiclient.Client
+	// pathPart is the path of the collection. Parent SIDs are given as `{attribute}` placeholders, e.g.
+	// "Workspaces/{workspace_sid}/Workers"; each becomes a required, ForceNew attribute and part of the import ID.
+	pathPart string
+	// singleton marks pathPart as a configuration resource that always exists, e.g. "Services/{service_sid}/Configuration".
+	// Create and update both post to it and delete leaves it as it is, as Twilio can't delete it. It is identified by
+	// its parent SIDs.
+	singleton bool
+	// reset is posted to a singleton on delete, to turn off what it configured, e.g. by clearing webhook URLs.
+	reset url.Values
+	// idAttribute names the attribute identifying the resource in its collection, e.g. "key" for Sync map items.
+	// Defaults to "sid".
+	idAttribute string
+	// model returns a new, empty model struct.
+	model func() interface{}
+	// schema overrides attributes derived from the model, for what tag options can't express. Parent attributes are
+	// added by build.
+	schema map[string]*schema.Schema
+	// timeouts overrides defaultOperationTimeout for slow operations, keyed by schema.TimeoutCreate and the like.
+	timeouts map[string]time.Duration
+}
+
+// resourceSchemaOptions names the provider's validators for `validate=` tag options.
+var resourceSchemaOptions = &mapper.SchemaOptions{
+	Validators: map[string]schema.SchemaValidateFunc{
+		"url":         validateURL,
+		"http_method": validateHTTPMethod,
+	},
+}
+
+var pathParentPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// parents returns the attributes holding parent SIDs, in the order they appear in the path.
+func (r *twilioResource) parents() []string {
+	var parents []string
+	for _, match := range pathParentPattern.FindAllStringSubmatch(r.pathPart, -1) {
+		parents = append(parents, match[1])
+	}
+	return parents
+}
+
+// path fills the parent SIDs into the collection path.
+func (r *twilioResource) path(d *schema.ResourceData) string {
+	return pathParentPattern.ReplaceAllStringFunc(r.pathPart, func(placeholder string) string {
+		return d.Get(placeholder[1 : len(placeholder)-1]).(string)
+	})
+}
+
+// target returns the collection path and SID the client calls address. A singleton is addressed as the last element
+// of its own path.
+func (r *twilioResource) target(d *schema.ResourceData) (string, string) {
+	if !r.singleton {
+		return r.path(d), d.Id()
+	}
+	collection, sid := path.Split(r.path(d))
+	return strings.TrimSuffix(collection, "/"), sid
+}
+
+// singletonID identifies a singleton by its parent SIDs.
+func (r *twilioResource) singletonID(d *schema.ResourceData) string {
+	var sids []string
+	for _, parent := range r.parents() {
+		sids = append(sids, d.Get(parent).(string))
+	}
+	if len(sids) == 0 {
+		return r.pathPart
+	}
+	return strings.Join(sids, "/")
+}
+
+func (r *twilioResource) build() *schema.Resource {
+	sm, err := mapper.SchemaFromStruct(r.model(), resourceSchemaOptions)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid model for %s: %s", r.name, err))
+	}
+	for name, s := range r.schema {
+		sm[name] = s
+	}
+
+	parents := r.parents()
+	for _, parent := range parents {
+		sm[parent] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		}
+	}
+	r.schema = sm
+
+	importer := &schema.ResourceImporter{
+		State: schema.ImportStatePassthrough,
+	}
+	switch {
+	case r.singleton && len(parents) > 0:
+		importer.State = importSingletonState(parents...)
+	case len(parents) > 0:
+		importer.State = importStateWithParentSid(parents...)
+	}
+
+	resource := &schema.Resource{
+		Create:   r.create,
+		Read:     r.read,
+		Update:   r.update,
+		Delete:   r.delete,
+		Importer: importer,
+		Timeouts: resourceTimeouts(r.timeouts),
+		Schema:   r.schema,
+	}
+
+	// Resources Twilio can't update are replaced whenever they change.
+	if !hasUpdatableAttributes(r.schema) {
+		resource.Update = nil
+		resource.Timeouts.Update = nil
+	}
+
+	return resource
+}
+
+func hasUpdatableAttributes(sm map[string]*schema.Schema) bool {
+	for _, s := range sm {
+		if !s.ForceNew && (s.Required || s.Optional) {
+			return true
+		}
+	}
+	return false
+}
+
+// combineResources manages several resources built from twilioResources, which must share their parents, as one:
+// e.g. a service's configuration and its notification settings, which Twilio keeps at separate paths. The schemas
+// are merged, and each CRUD function calls those of every resource in turn.
+func combineResources(resources ...*schema.Resource) *schema.Resource {
+	combined := &schema.Resource{
+		Importer: resources[0].Importer,
+		Timeouts: resources[0].Timeouts,
+		Schema:   make(map[string]*schema.Schema),
+	}
+
+	var creates, reads, updates, deletes []func(*schema.ResourceData, interface{}) error
+	for _, resource := range resources {
+		for name, s := range resource.Schema {
+			combined.Schema[name] = s
+		}
+		creates = append(creates, resource.Create)
+		reads = append(reads, resource.Read)
+		if resource.Update != nil {
+			updates = append(updates, resource.Update)
+		}
+		deletes = append(deletes, resource.Delete)
+	}
+
+	combined.Create = chainResourceFuncs(creates)
+	combined.Read = chainResourceFuncs(reads)
+	if len(updates) > 0 {
+		combined.Update = chainResourceFuncs(updates)
+	}
+	combined.Delete = chainResourceFuncs(deletes)
+	return combined
+}
+
+func chainResourceFuncs(funcs []func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// logFields returns the fields identifying the resource in log messages.
+func (r *twilioResource) logFields(d *schema.ResourceData, config Config) log.Fields {
+	fields := log.Fields{
+		"account_sid": config.AccountSID,
+	}
+	for _, parent := range r.parents() {
+		fields[parent] = d.Get(parent)
+	}
+	if d.Id() != "" {
+		fields["sid"] = d.Id()
+	}
+	return fields
+}
+
+// expand builds the create or update parameters from the model's `form` tags. Attributes that aren't set or haven't
+// changed are left out, so that Twilio keeps its defaults and partial updates don't blank out other values.
+func (r *twilioResource) expand(d *schema.ResourceData) (url.Values, error) {
+	model := r.model()
+	if err := mapper.UnmarshalFromTerraform(d, model); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(model)
+}
+
+// flatten writes the model to state and returns its ID within the collection.
+func (r *twilioResource) flatten(d *schema.ResourceData, model interface{}) (string, error) {
+	values, err := mapper.MapStructByTag(model, "terraform")
+	if err != nil {
+		return "", err
+	}
+
+	if err := mapper.MarshalToTerraform(model, d, r.schema); err != nil {
+		return "", err
+	}
+
+	idAttribute := r.idAttribute
+	if idAttribute == "" {
+		idAttribute = "sid"
+	}
+	if values[idAttribute] == nil {
+		return "", nil
+	}
+	return fmt.Sprint(values[idAttribute]), nil
+}
+
+func (r *twilioResource) create(d *schema.ResourceData, meta interface{}) error {
+	log.Debugf("ENTER %s.Create", r.name)
+
+	twilioContext := meta.(*TerraformTwilioContext)
+	client := r.client(twilioContext)
+	context, cancel := twilioContext.operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := r.expand(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("START client.%s.Create", r.name)
+
+	model := r.model()
+	if r.singleton {
+		collection, sid := r.target(d)
+		err = client.UpdateResource(context, collection, sid, createParams, model)
+	} else {
+		err = client.CreateResource(context, r.path(d), createParams, model)
+	}
+	if err != nil {
+		log.WithFields(r.logFields(d, twilioContext.configuration)).WithError(err).Errorf("client.%s.Create failed", r.name)
+
+		return err
+	}
+
+	sid, err := r.flatten(d, model)
+	if err != nil {
+		return err
+	}
+	if r.singleton {
+		sid = r.singletonID(d)
+	}
+	d.SetId(sid)
+	return nil
+}
+
+func (r *twilioResource) read(d *schema.ResourceData, meta interface{}) error {
+	log.Debugf("ENTER %s.Read", r.name)
+
+	twilioContext := meta.(*TerraformTwilioContext)
+	client := r.client(twilioContext)
+	context, cancel := twilioContext.operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("START client.%s.Get", r.name)
+
+	model := r.model()
+	collection, sid := r.target(d)
+	err := client.GetResource(context, collection, sid, model)
+	if isNotFound(err) {
+		// Removed outside of Terraform; clearing the ID plans it to be created again.
+		log.WithFields(r.logFields(d, twilioContext.configuration)).Warnf("%s no longer exists, removing it from the state", r.description)
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(r.logFields(d, twilioContext.configuration)).WithError(err).Errorf("client.%s.Get failed", r.name)
+
+		return err
+	}
+
+	_, err = r.flatten(d, model)
+	return err
+}
+
+func isNotFound(err error) bool {
+	restErr, ok := err.(*rest.Error)
+	return ok && restErr.Status == http.StatusNotFound
+}
+
+// formatTwilioTime formats a date as RFC 3339, as mapper.MarshalToTerraform does, for resources that set their state
+// by hand.
+func formatTwilioTime(t twiclient.TwilioTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+func (r *twilioResource) update(d *schema.ResourceData, meta interface{}) error {
+	log.Debugf("ENTER %s.Update", r.name)
+
+	twilioContext := meta.(*TerraformTwilioContext)
+	client := r.client(twilioContext)
+	context, cancel := twilioContext.operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	updateParams, err := r.expand(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("START client.%s.Update", r.name)
+
+	model := r.model()
+	collection, sid := r.target(d)
+	err = client.UpdateResource(context, collection, sid, updateParams, model)
+	if err != nil {
+		log.WithFields(r.logFields(d, twilioContext.configuration)).WithError(err).Errorf("client.%s.Update failed", r.name)
+
+		return err
+	}
+
+	_, err = r.flatten(d, model)
+	return err
+}
+
+func (r *twilioResource) delete(d *schema.ResourceData, meta interface{}) error {
+	log.Debugf("ENTER %s.Delete", r.name)
+
+	twilioContext := meta.(*TerraformTwilioContext)
+	if r.singleton && r.reset == nil {
+		log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("%s can't be deleted, leaving it as it is", r.name)
+
+		return nil
+	}
+
+	client := r.client(twilioContext)
+	context, cancel := twilioContext.operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	if r.singleton {
+		log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("START client.%s.Reset", r.name)
+
+		collection, sid := r.target(d)
+		if err := client.UpdateResource(context, collection, sid, r.reset, r.model()); err != nil {
+			return fmt.Errorf("Failed to reset %s: %s", r.description, err.Error())
+		}
+		return nil
+	}
+
+	log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("START client.%s.Delete", r.name)
+
+	err := client.DeleteResource(context, r.path(d), d.Id())
+
+	log.WithFields(r.logFields(d, twilioContext.configuration)).Debugf("END client.%s.Delete", r.name)
+	if err != nil {
+		return fmt.Errorf("Failed to delete %s: %s", r.description, err.Error())
+	}
+	return nil
+}
@@ -1,14 +1,24 @@
 package twilio
 
 import (
-	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
 	log "github.com/sirupsen/logrus"
 )
 
+// phoneNumberCreateTimeout is the default create timeout, as buying a number searches for one first and then buys it.
+const phoneNumberCreateTimeout = 15 * time.Minute
+
+// phoneNumberParams are the parameters sent when a number is bought or updated.
+type phoneNumberParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+	TrunkSid     *string `terraform:"trunk_sid" form:"TrunkSid"`
+}
+
 func resourceTwilioPhoneNumber() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTwilioPhoneNumberCreate,
@@ -18,6 +28,9 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: resourceTimeouts(map[string]time.Duration{
+			schema.TimeoutCreate: phoneNumberCreateTimeout,
+		}),
 		Schema: map[string]*schema.Schema{
 			"sid": &schema.Schema{
 				Type:     schema.TypeString,
@@ -35,6 +48,17 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			// Computed, so that a number attached with twilio_sip_trunk_phone_number isn't detached again. Only one of
+			// the two should be used for a number.
+			"trunk_sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			// TODO: We should also be able to handle "capabilities" but skipping it
 			// because it is challenging to parse lists and pass them along to the underlying
 			// go library
@@ -56,11 +80,21 @@ func flattenPhoneNumberForSearch(d *schema.ResourceData) url.Values {
 	return v
 }
 
-func flattenPhoneNumberForBuying(d *schema.ResourceData, phoneNumber string) url.Values {
-	v := make(url.Values)
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
+func flattenPhoneNumberForBuying(d *schema.ResourceData, phoneNumber string) (url.Values, error) {
+	v, err := flattenPhoneNumberForUpdate(d)
+	if err != nil {
+		return nil, err
+	}
 	v.Add("PhoneNumber", phoneNumber)
-	return v
+	return v, nil
+}
+
+func flattenPhoneNumberForUpdate(d *schema.ResourceData) (url.Values, error) {
+	params := new(phoneNumberParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
 }
 
 func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) error {
@@ -68,7 +102,8 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
 
 	searchParams := flattenPhoneNumberForSearch(d)
 	countryCode := d.Get("country_code").(string)
@@ -100,7 +135,10 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	phoneNumber := numbers.Numbers[0]
-	createParams := flattenPhoneNumberForBuying(d, string(phoneNumber.PhoneNumber))
+	createParams, err := flattenPhoneNumberForBuying(d, string(phoneNumber.PhoneNumber))
+	if err != nil {
+		return err
+	}
 
 	log.WithFields(
 		log.Fields{
@@ -120,10 +158,11 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(boughtNumber.Sid)
 	d.Set("friendly_name", boughtNumber.FriendlyName)
-	d.Set("phone_number", boughtNumber.PhoneNumber)
-	d.Set("date_created", boughtNumber.DateCreated)
-	d.Set("date_updated", boughtNumber.DateUpdated)
+	d.Set("phone_number", string(boughtNumber.PhoneNumber))
+	d.Set("date_created", formatTwilioTime(boughtNumber.DateCreated))
+	d.Set("date_updated", formatTwilioTime(boughtNumber.DateUpdated))
 	d.Set("capabilities", boughtNumber.Capabilities)
+	d.Set("trunk_sid", boughtNumber.TrunkSid.String)
 	return nil
 }
 
@@ -132,7 +171,8 @@ func resourceTwilioPhoneNumberRead(d *schema.ResourceData, meta interface{}) err
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
 
 	sid := d.Id()
 
@@ -143,6 +183,16 @@ func resourceTwilioPhoneNumberRead(d *schema.ResourceData, meta interface{}) err
 	).Debug("START client.IncomingNumbers.Get")
 
 	phoneNumber, err := client.IncomingNumbers.Get(context, sid)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).Warn("phone number no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		log.WithFields(
 			log.Fields{
@@ -153,14 +203,48 @@ func resourceTwilioPhoneNumberRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 	d.Set("friendly_name", phoneNumber.FriendlyName)
-	d.Set("phone_number", phoneNumber.PhoneNumber)
-	d.Set("date_created", phoneNumber.DateCreated)
-	d.Set("date_updated", phoneNumber.DateUpdated)
+	d.Set("phone_number", string(phoneNumber.PhoneNumber))
+	d.Set("date_created", formatTwilioTime(phoneNumber.DateCreated))
+	d.Set("date_updated", formatTwilioTime(phoneNumber.DateUpdated))
 	d.Set("capabilities", phoneNumber.Capabilities)
+	d.Set("trunk_sid", phoneNumber.TrunkSid.String)
 	return nil
 }
 
 func resourceTwilioPhoneNumberUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenPhoneNumberForUpdate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":     config.AccountSID,
+			"phoneNumber_sid": sid,
+		},
+	).Debug("START client.IncomingNumbers.Update")
+
+	phoneNumber, err := client.IncomingNumbers.Update(context, sid, updateParams)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":     config.AccountSID,
+				"phoneNumber_sid": sid,
+			},
+		).WithError(err).Error("client.IncomingNumbers.Update failed")
+
+		return err
+	}
+	d.Set("friendly_name", phoneNumber.FriendlyName)
+	d.Set("trunk_sid", phoneNumber.TrunkSid.String)
 	return nil
 }
 
@@ -169,7 +253,8 @@ func resourceTwilioPhoneNumberDelete(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
 
 	sid := d.Id()
 
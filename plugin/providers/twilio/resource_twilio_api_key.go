@@ -0,0 +1,558 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	apiKeyPathPart     = "Keys"
+	signingKeyPathPart = "SigningKeys"
+)
+
+// accountResourcePath returns the full path of an Account API resource (e.g. "/2010-04-01/Accounts/AC123/Keys.json")
+// for the given account, which lets requests target a subaccount using the parent account's credentials.
+func accountResourcePath(accountSid string, pathPart string) string {
+	return "/" + strings.Join([]string{twiclient.APIVersion, "Accounts", accountSid, pathPart + ".json"}, "/")
+}
+
+func resourceTwilioAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioAPIKeyCreate,
+		Read:   resourceTwilioAPIKeyRead,
+		Update: restrictedKeyFunc(resourceTwilioRestrictedKeyUpdate, resourceTwilioKeyUpdate(apiKeyPathPart)),
+		Delete: restrictedKeyFunc(resourceTwilioRestrictedKeyDelete, resourceTwilioKeyDelete(apiKeyPathPart)),
+		Importer: &schema.ResourceImporter{
+			State: importStateWithParentSid("account_sid"),
+		},
+		CustomizeDiff: resourceTwilioAPIKeyCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Main keys can only be minted in the Twilio Console, so they can't be managed here.
+			"key_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "standard",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "restricted"}, false),
+			},
+			"policy": jsonattr.Attribute(&schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			}),
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTwilioSigningKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSigningKeyCreate,
+		Read:   resourceTwilioKeyRead(signingKeyPathPart),
+		Update: resourceTwilioKeyUpdate(signingKeyPathPart),
+		Delete: resourceTwilioKeyDelete(signingKeyPathPart),
+		Importer: &schema.ResourceImporter{
+			State: importStateWithParentSid("account_sid"),
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// iamKey is a key as returned by the IAM API, which restricted keys are managed through.
+type iamKey struct {
+	twiclient.Key
+	Policy jsonattr.Document `json:"policy"`
+}
+
+// keyParams are the parameters sent when an API or signing key is created or updated.
+type keyParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+}
+
+// restrictedKeyFunc calls restricted for restricted API keys, which only the IAM API knows about, and standard for
+// any other key.
+func restrictedKeyFunc(restricted, standard func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if d.Get("key_type").(string) == "restricted" {
+			return restricted(d, meta)
+		}
+		return standard(d, meta)
+	}
+}
+
+func flattenKeyForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(keyParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+// resourceTwilioAPIKeyCustomizeDiff rejects a policy on a standard key during `terraform plan`.
+func resourceTwilioAPIKeyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("key_type") || d.Get("key_type").(string) == "restricted" {
+		return nil
+	}
+	if policy, ok := d.GetOk("policy"); ok && policy.(string) != "" {
+		return fmt.Errorf("policy can only be set on restricted API keys")
+	}
+	return nil
+}
+
+// resourceTwilioAPIKeyRead reads the key through the API its type is managed by. Imported keys don't have a type in
+// state yet, so it is looked up first.
+func resourceTwilioAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioAPIKeyRead")
+
+	if d.Get("key_type").(string) == "" {
+		keyType, err := lookupAPIKeyType(d, meta)
+		if err != nil {
+			return err
+		}
+		d.Set("key_type", keyType)
+	}
+
+	return restrictedKeyFunc(resourceTwilioRestrictedKeyRead, resourceTwilioKeyRead(apiKeyPathPart))(d, meta)
+}
+
+// lookupAPIKeyType tells restricted keys, which the IAM API knows about, from standard ones.
+func lookupAPIKeyType(d *schema.ResourceData, meta interface{}) (string, error) {
+	client := meta.(*TerraformTwilioContext).iam
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+	accountSid := keyAccountSid(d, config)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+			"key_sid":     sid,
+		},
+	).Debug("START client.IAM.Keys.Get")
+
+	err := client.GetResource(context, apiKeyPathPart, sid, new(iamKey))
+	if isNotFound(err) {
+		return "standard", nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).WithError(err).Error("client.IAM.Keys.Get failed")
+
+		return "", err
+	}
+	return "restricted", nil
+}
+
+// keyAccountSid returns the account the key belongs to, which defaults to the account managed by the provider.
+func keyAccountSid(d *schema.ResourceData, config Config) string {
+	if accountSid := d.Get("account_sid").(string); accountSid != "" {
+		return accountSid
+	}
+	return config.managedAccountSID()
+}
+
+func setKeyData(d *schema.ResourceData, accountSid string, key *twiclient.Key) {
+	d.Set("sid", key.Sid)
+	d.Set("account_sid", accountSid)
+	d.Set("friendly_name", key.FriendlyName)
+	d.Set("date_created", formatTwilioTime(key.DateCreated))
+
+	// The secret is only ever returned when the key is created.
+	if key.Secret != "" {
+		d.Set("secret", key.Secret)
+	}
+}
+
+func setRestrictedKeyData(d *schema.ResourceData, accountSid string, key *iamKey) {
+	setKeyData(d, accountSid, &key.Key)
+	d.Set("key_type", "restricted")
+
+	// Keys that were created without a policy don't return one.
+	if key.Policy != "" {
+		d.Set("policy", string(key.Policy))
+	}
+}
+
+func resourceTwilioAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioAPIKeyCreate")
+
+	if d.Get("key_type").(string) != "restricted" {
+		return createKey(d, meta, apiKeyPathPart)
+	}
+
+	client := meta.(*TerraformTwilioContext).iam
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	accountSid := keyAccountSid(d, config)
+
+	createParams, err := flattenKeyForCreate(d)
+	if err != nil {
+		return err
+	}
+	createParams.Add("AccountSid", accountSid)
+	createParams.Add("KeyType", "restricted")
+	if policy, ok := d.GetOk("policy"); ok {
+		createParams.Add("Policy", policy.(string))
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+		},
+	).Debug("START client.IAM.Keys.Create")
+
+	key := new(iamKey)
+	err = client.CreateResource(context, apiKeyPathPart, createParams, key)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+			},
+		).WithError(err).Error("client.IAM.Keys.Create failed")
+
+		return err
+	}
+	d.SetId(key.Sid)
+	setRestrictedKeyData(d, accountSid, key)
+	return nil
+}
+
+func resourceTwilioRestrictedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioRestrictedKeyRead")
+
+	client := meta.(*TerraformTwilioContext).iam
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+	accountSid := keyAccountSid(d, config)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+			"key_sid":     sid,
+		},
+	).Debug("START client.IAM.Keys.Get")
+
+	key := new(iamKey)
+	err := client.GetResource(context, apiKeyPathPart, sid, key)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).Warn("key no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).WithError(err).Error("client.IAM.Keys.Get failed")
+
+		return err
+	}
+	setRestrictedKeyData(d, accountSid, key)
+	return nil
+}
+
+func resourceTwilioRestrictedKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioRestrictedKeyUpdate")
+
+	client := meta.(*TerraformTwilioContext).iam
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	accountSid := keyAccountSid(d, config)
+	updateParams, err := flattenKeyForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+			"key_sid":     sid,
+		},
+	).Debug("START client.IAM.Keys.Update")
+
+	key := new(iamKey)
+	err = client.UpdateResource(context, apiKeyPathPart, sid, updateParams, key)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).WithError(err).Error("client.IAM.Keys.Update failed")
+
+		return err
+	}
+	setRestrictedKeyData(d, accountSid, key)
+	return nil
+}
+
+func resourceTwilioRestrictedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioRestrictedKeyDelete")
+
+	client := meta.(*TerraformTwilioContext).iam
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+	accountSid := keyAccountSid(d, config)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+			"key_sid":     sid,
+		},
+	).Debug("START client.IAM.Keys.Delete")
+
+	err := client.DeleteResource(context, apiKeyPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+			"key_sid":     sid,
+		},
+	).Debug("END client.IAM.Keys.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete key: %s", err.Error())
+	}
+	return nil
+}
+
+func resourceTwilioSigningKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSigningKeyCreate")
+
+	return createKey(d, meta, signingKeyPathPart)
+}
+
+func createKey(d *schema.ResourceData, meta interface{}, pathPart string) error {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	accountSid := keyAccountSid(d, config)
+	createParams, err := flattenKeyForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": accountSid,
+		},
+	).Debugf("START client.%s.Create", pathPart)
+
+	key := new(twiclient.Key)
+	err = client.MakeRequest(context, "POST", accountResourcePath(accountSid, pathPart), createParams, key)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+			},
+		).WithError(err).Errorf("client.%s.Create failed", pathPart)
+
+		return err
+	}
+	d.SetId(key.Sid)
+	setKeyData(d, accountSid, key)
+	return nil
+}
+
+func resourceTwilioKeyRead(pathPart string) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		log.Debugf("ENTER resourceTwilio%sRead", pathPart)
+
+		client := meta.(*TerraformTwilioContext).client
+		config := meta.(*TerraformTwilioContext).configuration
+		context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+		defer cancel()
+
+		sid := d.Id()
+		accountSid := keyAccountSid(d, config)
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).Debugf("START client.%s.Get", pathPart)
+
+		key := new(twiclient.Key)
+		err := client.MakeRequest(context, "GET", accountResourcePath(accountSid, pathPart+"/"+sid), nil, key)
+		if isNotFound(err) {
+			log.WithFields(
+				log.Fields{
+					"account_sid": accountSid,
+					"key_sid":     sid,
+				},
+			).Warn("key no longer exists, removing it from the state")
+
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid": accountSid,
+					"key_sid":     sid,
+				},
+			).WithError(err).Errorf("client.%s.Get failed", pathPart)
+
+			return err
+		}
+		setKeyData(d, accountSid, key)
+		return nil
+	}
+}
+
+func resourceTwilioKeyUpdate(pathPart string) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		log.Debugf("ENTER resourceTwilio%sUpdate", pathPart)
+
+		client := meta.(*TerraformTwilioContext).client
+		config := meta.(*TerraformTwilioContext).configuration
+		context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+		defer cancel()
+
+		sid := d.Id()
+		accountSid := keyAccountSid(d, config)
+		updateParams, err := flattenKeyForCreate(d)
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).Debugf("START client.%s.Update", pathPart)
+
+		key := new(twiclient.Key)
+		err = client.MakeRequest(context, "POST", accountResourcePath(accountSid, pathPart+"/"+sid), updateParams, key)
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid": accountSid,
+					"key_sid":     sid,
+				},
+			).WithError(err).Errorf("client.%s.Update failed", pathPart)
+
+			return err
+		}
+		setKeyData(d, accountSid, key)
+		return nil
+	}
+}
+
+func resourceTwilioKeyDelete(pathPart string) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		log.Debugf("ENTER resourceTwilio%sDelete", pathPart)
+
+		client := meta.(*TerraformTwilioContext).client
+		config := meta.(*TerraformTwilioContext).configuration
+		context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+		defer cancel()
+
+		sid := d.Id()
+		accountSid := keyAccountSid(d, config)
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).Debugf("START client.%s.Delete", pathPart)
+
+		err := client.MakeRequest(context, "DELETE", accountResourcePath(accountSid, pathPart+"/"+sid), nil, nil)
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": accountSid,
+				"key_sid":     sid,
+			},
+		).Debugf("END client.%s.Delete", pathPart)
+		if err != nil {
+			return fmt.Errorf("Failed to delete key: %s", err.Error())
+		}
+		return nil
+	}
+}
@@ -1,14 +1,42 @@
 package twilio
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
 	log "github.com/sirupsen/logrus"
 )
 
+const applicationPathPart = "Applications"
+
+// twilioApplication extends the twilio-go Application with the fields the library doesn't decode.
+type twilioApplication struct {
+	twiclient.Application
+	SMSMethod         string `json:"sms_method"`
+	SMSStatusCallback string `json:"sms_status_callback"`
+}
+
+// applicationParams are the parameters sent when an application is created or updated.
+type applicationParams struct {
+	FriendlyName          *string `terraform:"friendly_name" form:"FriendlyName"`
+	VoiceURL              *string `terraform:"voice_url" form:"VoiceUrl"`
+	VoiceMethod           *string `terraform:"voice_method" form:"VoiceMethod"`
+	VoiceFallbackURL      *string `terraform:"voice_fallback_url" form:"VoiceFallbackUrl"`
+	VoiceFallbackMethod   *string `terraform:"voice_fallback_method" form:"VoiceFallbackMethod"`
+	VoiceCallerIDLookup   *bool   `terraform:"voice_caller_id_lookup" form:"VoiceCallerIdLookup"`
+	StatusCallback        *string `terraform:"status_callback" form:"StatusCallback"`
+	StatusCallbackMethod  *string `terraform:"status_callback_method" form:"StatusCallbackMethod"`
+	SMSURL                *string `terraform:"sms_url" form:"SmsUrl"`
+	SMSMethod             *string `terraform:"sms_method" form:"SmsMethod"`
+	SMSFallbackURL        *string `terraform:"sms_fallback_url" form:"SmsFallbackUrl"`
+	SMSFallbackMethod     *string `terraform:"sms_fallback_method" form:"SmsFallbackMethod"`
+	SMSStatusCallback     *string `terraform:"sms_status_callback" form:"SmsStatusCallback"`
+	MessageStatusCallback *string `terraform:"message_status_callback" form:"MessageStatusCallback"`
+}
+
 func resourceTwilioApplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTwilioApplicationCreate,
@@ -18,6 +46,7 @@ func resourceTwilioApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"sid": &schema.Schema{
 				Type:     schema.TypeString,
@@ -27,15 +56,116 @@ func resourceTwilioApplication() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"voice_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"voice_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"voice_fallback_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"voice_fallback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"voice_caller_id_lookup": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status_callback": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"status_callback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"sms_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"sms_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"sms_fallback_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"sms_fallback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"sms_status_callback": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"message_status_callback": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func flattenApplicationForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
+// flattenApplicationForCreate builds the create or update parameters. Only attributes that are set or changed are
+// sent, so that unset attributes keep Twilio's defaults.
+func flattenApplicationForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(applicationParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
 
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-	return v
+func setApplicationData(d *schema.ResourceData, application *twilioApplication) {
+	d.Set("sid", application.Sid)
+	d.Set("friendly_name", application.FriendlyName)
+	d.Set("voice_url", application.VoiceURL)
+	d.Set("voice_method", application.VoiceMethod)
+	d.Set("voice_fallback_url", application.VoiceFallbackURL)
+	d.Set("voice_fallback_method", application.VoiceFallbackMethod)
+	d.Set("voice_caller_id_lookup", application.VoiceCallerIDLookup)
+	d.Set("status_callback", application.StatusCallback)
+	d.Set("status_callback_method", application.StatusCallbackMethod)
+	d.Set("sms_url", application.SMSURL)
+	d.Set("sms_method", application.SMSMethod)
+	d.Set("sms_fallback_url", application.SMSFallbackURL)
+	d.Set("sms_fallback_method", application.SMSFallbackMethod)
+	d.Set("sms_status_callback", application.SMSStatusCallback)
+	d.Set("message_status_callback", application.MessageStatusCallback)
+	d.Set("date_created", formatTwilioTime(application.DateCreated))
+	d.Set("date_updated", formatTwilioTime(application.DateUpdated))
 }
 
 func resourceTwilioApplicationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -43,9 +173,13 @@ func resourceTwilioApplicationCreate(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
 
-	createParams := flattenApplicationForCreate(d)
+	createParams, err := flattenApplicationForCreate(d)
+	if err != nil {
+		return err
+	}
 
 	log.WithFields(
 		log.Fields{
@@ -53,7 +187,8 @@ func resourceTwilioApplicationCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	).Debug("START client.Applications.Create")
 
-	application, err := client.Applications.Create(context, createParams)
+	application := new(twilioApplication)
+	err = client.CreateResource(context, applicationPathPart, createParams, application)
 	if err != nil {
 		log.WithFields(
 			log.Fields{
@@ -64,11 +199,7 @@ func resourceTwilioApplicationCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 	d.SetId(application.Sid)
-	d.Set("friendly_name", application.FriendlyName)
-	d.Set("date_created", application.DateCreated)
-	d.Set("date_updated", application.DateUpdated)
-	d.Set("voice_url", application.VoiceURL)
-	d.Set("sms_url", application.SMSURL)
+	setApplicationData(d, application)
 	return nil
 }
 
@@ -77,7 +208,8 @@ func resourceTwilioApplicationRead(d *schema.ResourceData, meta interface{}) err
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
 
 	sid := d.Id()
 
@@ -87,7 +219,18 @@ func resourceTwilioApplicationRead(d *schema.ResourceData, meta interface{}) err
 		},
 	).Debug("START client.Applications.Get")
 
-	application, err := client.Applications.Get(context, sid)
+	application := new(twilioApplication)
+	err := client.GetResource(context, applicationPathPart, sid, application)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).Warn("application no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		log.WithFields(
 			log.Fields{
@@ -97,15 +240,44 @@ func resourceTwilioApplicationRead(d *schema.ResourceData, meta interface{}) err
 
 		return err
 	}
-	d.Set("friendly_name", application.FriendlyName)
-	d.Set("date_created", application.DateCreated)
-	d.Set("date_updated", application.DateUpdated)
-	d.Set("voice_url", application.VoiceURL)
-	d.Set("sms_url", application.SMSURL)
+	setApplicationData(d, application)
 	return nil
 }
 
 func resourceTwilioApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioApplicationUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenApplicationForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":     config.AccountSID,
+			"application_sid": sid,
+		},
+	).Debug("START client.Applications.Update")
+
+	application := new(twilioApplication)
+	err = client.UpdateResource(context, applicationPathPart, sid, updateParams, application)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":     config.AccountSID,
+				"application_sid": sid,
+			},
+		).WithError(err).Error("client.Applications.Update failed")
+
+		return err
+	}
+	setApplicationData(d, application)
 	return nil
 }
 
@@ -114,7 +286,8 @@ func resourceTwilioApplicationDelete(d *schema.ResourceData, meta interface{}) e
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
 
 	sid := d.Id()
 
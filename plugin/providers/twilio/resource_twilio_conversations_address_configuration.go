@@ -0,0 +1,45 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// conversationsAutoCreation configures how a conversation is created for messages to an address that isn't in one.
+type conversationsAutoCreation struct {
+	Enabled                bool     `json:"enabled" terraform:"enabled,required" form:"Enabled"`
+	Type                   *string  `json:"type" terraform:"type,optional,computed,oneof=webhook|studio|default" form:"Type"`
+	ConversationServiceSid *string  `json:"conversation_service_sid" terraform:"conversation_service_sid,optional,computed" form:"ConversationServiceSid"`
+	WebhookURL             *string  `json:"webhook_url" terraform:"webhook_url,validate=url" form:"WebhookUrl"`
+	WebhookMethod          *string  `json:"webhook_method" terraform:"webhook_method,optional,computed,oneof=GET|POST" form:"WebhookMethod"`
+	WebhookFilters         []string `json:"webhook_filters" terraform:"webhook_filters,set" form:"WebhookFilters"`
+	StudioFlowSid          *string  `json:"studio_flow_sid" terraform:"studio_flow_sid" form:"StudioFlowSid"`
+	StudioRetryCount       *int     `json:"studio_retry_count" terraform:"studio_retry_count,optional,computed,min=0,max=3" form:"StudioRetryCount"`
+}
+
+// conversationsAddressConfiguration makes incoming messages to an address, e.g. a phone number, start conversations
+// automatically. For more documentation, see https://www.twilio.com/docs/conversations/api/address-configuration-resource
+type conversationsAddressConfiguration struct {
+	Sid          string                     `json:"sid" terraform:"sid,computed"`
+	AccountSid   string                     `json:"account_sid" terraform:"account_sid,computed"`
+	Type         string                     `json:"type" terraform:"type,required,forcenew,oneof=sms|whatsapp|messenger|gbm" form:"Type,omitempty"`
+	Address      string                     `json:"address" terraform:"address,required,forcenew" form:"Address,omitempty"`
+	FriendlyName *string                    `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	AutoCreation *conversationsAutoCreation `json:"auto_creation" terraform:"auto_creation,optional,computed" form:"AutoCreation"`
+	DateCreated  twiclient.TwilioTime       `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime       `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string                     `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioConversationsAddressConfiguration() *schema.Resource {
+	r := &twilioResource{
+		name:        "Conversations.Configuration.Addresses",
+		description: "conversations address configuration",
+		client:      conversationsClient,
+		pathPart:    "Configuration/Addresses",
+		model: func() interface{} {
+			return new(conversationsAddressConfiguration)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,32 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// conversationsRole is a role in a Conversations Service, granting its permissions either within a conversation or
+// across the service. For more documentation, see https://www.twilio.com/docs/conversations/api/role-resource
+type conversationsRole struct {
+	Sid            string               `json:"sid" terraform:"sid,computed"`
+	ChatServiceSid string               `json:"chat_service_sid" terraform:"chat_service_sid"`
+	FriendlyName   string               `json:"friendly_name" terraform:"friendly_name,required,forcenew" form:"FriendlyName,omitempty"`
+	Type           string               `json:"type" terraform:"type,required,forcenew,oneof=conversation|service" form:"Type,omitempty"`
+	Permissions    []string             `json:"permissions" terraform:"permissions,required,set" form:"Permission"`
+	DateCreated    twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated    twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL            string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioConversationsRole() *schema.Resource {
+	r := &twilioResource{
+		name:        "Conversations.Service.Roles",
+		description: "conversations role",
+		client:      conversationsClient,
+		pathPart:    "Services/{chat_service_sid}/Roles",
+		model: func() interface{} {
+			return new(conversationsRole)
+		},
+	}
+	return r.build()
+}
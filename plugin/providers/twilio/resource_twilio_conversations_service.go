@@ -0,0 +1,98 @@
+package twilio
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// conversationsService is a Conversations Service, which holds a set of conversations, users and roles. For more
+// documentation, see https://www.twilio.com/docs/conversations/api/service-resource
+type conversationsService struct {
+	Sid          string               `json:"sid" terraform:"sid,computed"`
+	AccountSid   string               `json:"account_sid" terraform:"account_sid,computed"`
+	FriendlyName string               `json:"friendly_name" terraform:"friendly_name,required,forcenew" form:"FriendlyName,omitempty"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string               `json:"url" terraform:"url,computed"`
+}
+
+// conversationsServiceConfiguration holds a Conversations Service's default roles and whether reachability is on. For
+// more documentation, see https://www.twilio.com/docs/conversations/api/service-configuration-resource
+type conversationsServiceConfiguration struct {
+	ChatServiceSid                    string  `json:"chat_service_sid" terraform:"chat_service_sid"`
+	DefaultConversationCreatorRoleSid *string `json:"default_conversation_creator_role_sid" terraform:"default_conversation_creator_role_sid,optional,computed" form:"DefaultConversationCreatorRoleSid"`
+	DefaultConversationRoleSid        *string `json:"default_conversation_role_sid" terraform:"default_conversation_role_sid,optional,computed" form:"DefaultConversationRoleSid"`
+	DefaultChatServiceRoleSid         *string `json:"default_chat_service_role_sid" terraform:"default_chat_service_role_sid,optional,computed" form:"DefaultChatServiceRoleSid"`
+	ReachabilityEnabled               *bool   `json:"reachability_enabled" terraform:"reachability_enabled,optional,computed" form:"ReachabilityEnabled"`
+	URL                               string  `json:"url" terraform:"url,computed"`
+}
+
+// conversationsNotification configures the push notification sent for one kind of event.
+type conversationsNotification struct {
+	Enabled           bool    `json:"enabled" terraform:"enabled,required" form:"Enabled"`
+	Template          *string `json:"template" terraform:"template,optional,computed" form:"Template"`
+	Sound             *string `json:"sound" terraform:"sound,optional,computed" form:"Sound"`
+	BadgeCountEnabled *bool   `json:"badge_count_enabled" terraform:"badge_count_enabled,optional,computed" form:"BadgeCountEnabled"`
+}
+
+// conversationsServiceNotifications holds a Conversations Service's push notification settings. For more
+// documentation, see https://www.twilio.com/docs/conversations/api/service-notification-resource
+type conversationsServiceNotifications struct {
+	ChatServiceSid          string                     `json:"chat_service_sid" terraform:"chat_service_sid"`
+	LogEnabled              *bool                      `json:"log_enabled" terraform:"notifications_log_enabled,optional,computed" form:"LogEnabled"`
+	NewMessage              *conversationsNotification `json:"new_message" terraform:"new_message_notification,optional,computed" form:"NewMessage"`
+	AddedToConversation     *conversationsNotification `json:"added_to_conversation" terraform:"added_to_conversation_notification,optional,computed" form:"AddedToConversation"`
+	RemovedFromConversation *conversationsNotification `json:"removed_from_conversation" terraform:"removed_from_conversation_notification,optional,computed" form:"RemovedFromConversation"`
+}
+
+func conversationsClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.conversations
+}
+
+func resourceTwilioConversationsService() *schema.Resource {
+	r := &twilioResource{
+		name:        "Conversations.Services",
+		description: "conversations service",
+		client:      conversationsClient,
+		pathPart:    "Services",
+		model: func() interface{} {
+			return new(conversationsService)
+		},
+	}
+	return r.build()
+}
+
+// resourceTwilioConversationsServiceConfiguration manages a service's configuration and its notification settings,
+// which Twilio keeps apart, as one resource. Destroying it turns the notifications off, and leaves the default roles
+// and reachability as they are.
+func resourceTwilioConversationsServiceConfiguration() *schema.Resource {
+	configuration := &twilioResource{
+		name:        "Conversations.Service.Configuration",
+		description: "conversations service configuration",
+		client:      conversationsClient,
+		pathPart:    "Services/{chat_service_sid}/Configuration",
+		singleton:   true,
+		model: func() interface{} {
+			return new(conversationsServiceConfiguration)
+		},
+	}
+	notifications := &twilioResource{
+		name:        "Conversations.Service.Configuration.Notifications",
+		description: "conversations service notifications",
+		client:      conversationsClient,
+		pathPart:    "Services/{chat_service_sid}/Configuration/Notifications",
+		singleton:   true,
+		reset: url.Values{
+			"LogEnabled":                      []string{"false"},
+			"NewMessage.Enabled":              []string{"false"},
+			"AddedToConversation.Enabled":     []string{"false"},
+			"RemovedFromConversation.Enabled": []string{"false"},
+		},
+		model: func() interface{} {
+			return new(conversationsServiceNotifications)
+		},
+	}
+	return combineResources(configuration.build(), notifications.build())
+}
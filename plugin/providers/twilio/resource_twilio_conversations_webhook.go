@@ -0,0 +1,38 @@
+package twilio
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// conversationsWebhook holds the URLs a Conversations Service calls before and after the events named in its filters.
+// For more documentation, see https://www.twilio.com/docs/conversations/api/service-webhook-configuration-resource
+type conversationsWebhook struct {
+	ChatServiceSid string   `json:"chat_service_sid" terraform:"chat_service_sid"`
+	PreWebhookURL  *string  `json:"pre_webhook_url" terraform:"pre_webhook_url,validate=url" form:"PreWebhookUrl"`
+	PostWebhookURL *string  `json:"post_webhook_url" terraform:"post_webhook_url,validate=url" form:"PostWebhookUrl"`
+	Filters        []string `json:"filters" terraform:"filters,set" form:"Filters"`
+	Method         *string  `json:"method" terraform:"method,optional,computed,oneof=GET|POST" form:"Method"`
+	URL            string   `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioConversationsWebhook() *schema.Resource {
+	r := &twilioResource{
+		name:        "Conversations.Service.Configuration.Webhooks",
+		description: "conversations webhook",
+		client:      conversationsClient,
+		pathPart:    "Services/{chat_service_sid}/Configuration/Webhooks",
+		singleton:   true,
+		// Twilio stops calling the webhooks once their URLs and filters are cleared.
+		reset: url.Values{
+			"PreWebhookUrl":  []string{""},
+			"PostWebhookUrl": []string{""},
+			"Filters":        []string{""},
+		},
+		model: func() interface{} {
+			return new(conversationsWebhook)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,195 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const eventsSinkPathPart = "Sinks"
+
+const (
+	// eventsSinkPollInterval is how often a sink's status is checked while waiting for it to become active.
+	eventsSinkPollInterval = 5 * time.Second
+	// eventsSinkValidationTimeout is the default update timeout, which includes waiting for validation.
+	eventsSinkValidationTimeout = 15 * time.Minute
+)
+
+// eventsSink is an Event Streams sink, a Kinesis stream or webhook that subscribed events are delivered to. Its
+// `sink_configuration` is the JSON document Twilio expects for the sink type, e.g. `arn`, `role_arn` and
+// `external_id` for Kinesis, or `destination`, `method` and `batch_events` for webhooks. For more documentation, see
+// https://www.twilio.com/docs/events/api/sink-resource
+type eventsSink struct {
+	Sid               string               `json:"sid" terraform:"sid,computed"`
+	Description       string               `json:"description" terraform:"description,required" form:"Description,omitempty"`
+	SinkType          string               `json:"sink_type" terraform:"sink_type,required,forcenew,oneof=kinesis|webhook" form:"SinkType,omitempty"`
+	SinkConfiguration jsonattr.Document    `json:"sink_configuration" terraform:"sink_configuration,required,forcenew,json" form:"SinkConfiguration,omitempty"`
+	Status            string               `json:"status" terraform:"status,computed"`
+	Validate          bool                 `json:"-" terraform:"validate,writeonly"`
+	TestID            string               `json:"-" terraform:"test_id,writeonly"`
+	DateCreated       twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated       twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL               string               `json:"url" terraform:"url,computed"`
+}
+
+// eventsSinkResult is the response to sending a test event to a sink and to validating it.
+type eventsSinkResult struct {
+	Result string `json:"result"`
+}
+
+func eventsClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.events
+}
+
+// resourceTwilioEventsSink manages a sink. With `validate` set, a test event is sent once the sink is created; setting
+// `test_id` to the ID that event carried then validates the sink, and the apply waits until it is active (see
+// https://www.twilio.com/docs/events/eventstreams-quickstart).
+func resourceTwilioEventsSink() *schema.Resource {
+	r := &twilioResource{
+		name:        "Events.Sinks",
+		description: "events sink",
+		client:      eventsClient,
+		pathPart:    eventsSinkPathPart,
+		model: func() interface{} {
+			return new(eventsSink)
+		},
+		timeouts: map[string]time.Duration{
+			schema.TimeoutUpdate: eventsSinkValidationTimeout,
+		},
+	}
+	resource := r.build()
+
+	create := resource.Create
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		if !d.Get("validate").(bool) {
+			return nil
+		}
+		return testEventsSink(d, meta, schema.TimeoutCreate)
+	}
+
+	update := resource.Update
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		if !d.Get("validate").(bool) || d.Get("status").(string) == "active" {
+			return nil
+		}
+		if d.HasChange("test_id") && d.Get("test_id").(string) != "" {
+			return validateEventsSink(d, meta)
+		}
+		if d.HasChange("validate") {
+			return testEventsSink(d, meta, schema.TimeoutUpdate)
+		}
+		return nil
+	}
+
+	return resource
+}
+
+// testEventsSink sends a test event to the sink, carrying the test ID validateEventsSink needs.
+func testEventsSink(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, timeoutKey)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"sink_sid":    sid,
+		},
+	).Debug("START client.Events.Sinks.Test")
+
+	err := client.CreateResource(context, fmt.Sprintf("%s/%s/Test", eventsSinkPathPart, sid), make(url.Values), new(eventsSinkResult))
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"sink_sid":    sid,
+			},
+		).WithError(err).Error("client.Events.Sinks.Test failed")
+
+		return fmt.Errorf("Failed to send a test event to events sink: %s", err.Error())
+	}
+	return nil
+}
+
+// validateEventsSink posts the test ID back to Twilio and waits, until the update times out, for the sink to become
+// active.
+func validateEventsSink(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"sink_sid":    sid,
+		},
+	).Debug("START client.Events.Sinks.Validate")
+
+	params := make(url.Values)
+	params.Add("TestId", d.Get("test_id").(string))
+
+	result := new(eventsSinkResult)
+	err := client.CreateResource(context, fmt.Sprintf("%s/%s/Validate", eventsSinkPathPart, sid), params, result)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"sink_sid":    sid,
+			},
+		).WithError(err).Error("client.Events.Sinks.Validate failed")
+
+		return fmt.Errorf("Failed to validate events sink: %s", err.Error())
+	}
+	if result.Result != "valid" {
+		return fmt.Errorf("Events sink %s failed validation (result %q)", sid, result.Result)
+	}
+
+	ticker := time.NewTicker(eventsSinkPollInterval)
+	defer ticker.Stop()
+
+	for {
+		sink := new(eventsSink)
+		if err := client.GetResource(context, eventsSinkPathPart, sid, sink); err != nil {
+			return fmt.Errorf("Failed to read events sink status: %s", err.Error())
+		}
+		d.Set("status", sink.Status)
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"sink_sid":    sid,
+				"status":      sink.Status,
+			},
+		).Debug("Waiting for events sink to become active")
+
+		switch sink.Status {
+		case "active":
+			return nil
+		case "failed":
+			return fmt.Errorf("Events sink %s failed validation", sid)
+		}
+
+		select {
+		case <-context.Done():
+			return fmt.Errorf("Timed out waiting for events sink %s to become active (status %q)", sid, sink.Status)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const eventsSubscriptionPathPart = "Subscriptions"
+
+// eventsSubscription delivers events of the subscribed types to a sink. For more documentation, see
+// https://www.twilio.com/docs/events/api/subscription-resource
+type eventsSubscription struct {
+	Sid         string               `json:"sid"`
+	AccountSid  string               `json:"account_sid"`
+	Description string               `json:"description"`
+	SinkSid     string               `json:"sink_sid"`
+	DateCreated twiclient.TwilioTime `json:"date_created"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated"`
+}
+
+// eventsSubscribedEvent is an event type a subscription delivers, at a given schema version. It is also the JSON
+// object each `Types` parameter holds when a subscription is created.
+type eventsSubscribedEvent struct {
+	Type          string `json:"type"`
+	SchemaVersion int    `json:"schema_version"`
+}
+
+// eventsSubscriptionParams are the parameters sent when a subscription is created or updated. Its types are sent on
+// creation only, and are managed as subscribed events after that.
+type eventsSubscriptionParams struct {
+	Description *string `terraform:"description" form:"Description"`
+	SinkSid     *string `terraform:"sink_sid" form:"SinkSid"`
+}
+
+type eventsSubscribedEventPage struct {
+	Types []*eventsSubscribedEvent `json:"types"`
+	Meta  twiclient.Meta           `json:"meta"`
+}
+
+func (p *eventsSubscribedEventPage) nextPage() string {
+	return p.Meta.NextPageURL.String
+}
+
+func resourceTwilioEventsSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioEventsSubscriptionCreate,
+		Read:   resourceTwilioEventsSubscriptionRead,
+		Update: resourceTwilioEventsSubscriptionUpdate,
+		Delete: resourceTwilioEventsSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceTwilioEventsSubscriptionCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sink_sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				// Event types are identified by their name, as in reconcileEventsSubscribedEvents.
+				Set: mapper.HashcodeByKeys("type"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schema_version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenEventsSubscriptionForUpdate(d *schema.ResourceData) (url.Values, error) {
+	params := new(eventsSubscriptionParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func flattenEventsSubscriptionForCreate(d *schema.ResourceData) (url.Values, error) {
+	v, err := flattenEventsSubscriptionForUpdate(d)
+	if err != nil {
+		return nil, err
+	}
+
+	for eventType, schemaVersion := range eventsSubscribedEventVersions(d.Get("type").(*schema.Set)) {
+		subscribedEvent, err := json.Marshal(&eventsSubscribedEvent{Type: eventType, SchemaVersion: schemaVersion})
+		if err != nil {
+			return nil, err
+		}
+		v.Add("Types", string(subscribedEvent))
+	}
+
+	return v, nil
+}
+
+func eventsSubscribedEventsPath(subscriptionSid string) string {
+	return fmt.Sprintf("%s/%s/SubscribedEvents", eventsSubscriptionPathPart, subscriptionSid)
+}
+
+// eventsSubscribedEventVersions maps event types to schema versions for a set of `type` blocks.
+func eventsSubscribedEventVersions(types *schema.Set) map[string]int {
+	versions := make(map[string]int)
+	for _, raw := range types.List() {
+		subscribedEvent := raw.(map[string]interface{})
+		versions[subscribedEvent["type"].(string)] = subscribedEvent["schema_version"].(int)
+	}
+	return versions
+}
+
+func listEventsSubscribedEvents(context context.Context, client *twiclient.Client, subscriptionSid string) (map[string]*eventsSubscribedEvent, error) {
+	subscribedEvents := make(map[string]*eventsSubscribedEvent)
+	err := listAllPages(context, client, eventsSubscribedEventsPath(subscriptionSid), url.Values{"PageSize": []string{"1000"}},
+		func() listPage {
+			return new(eventsSubscribedEventPage)
+		},
+		func(page listPage) {
+			for _, subscribedEvent := range page.(*eventsSubscribedEventPage).Types {
+				subscribedEvents[subscribedEvent.Type] = subscribedEvent
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return subscribedEvents, nil
+}
+
+// reconcileEventsSubscribedEvents adds, updates and removes subscribed events so that the subscription matches the
+// configuration. Events are added and updated before any are removed, as a subscription can't be left without one.
+func reconcileEventsSubscribedEvents(context context.Context, client *twiclient.Client, subscriptionSid string, types *schema.Set) error {
+	versions := eventsSubscribedEventVersions(types)
+
+	existing, err := listEventsSubscribedEvents(context, client, subscriptionSid)
+	if err != nil {
+		return err
+	}
+
+	for eventType, schemaVersion := range versions {
+		params := make(url.Values)
+		params.Add("SchemaVersion", fmt.Sprint(schemaVersion))
+
+		subscribedEvent, ok := existing[eventType]
+		if !ok {
+			params.Add("Type", eventType)
+
+			log.WithFields(
+				log.Fields{
+					"subscription_sid": subscriptionSid,
+					"type":             eventType,
+				},
+			).Debug("START client.Events.Subscriptions.SubscribedEvents.Create")
+
+			if err := client.CreateResource(context, eventsSubscribedEventsPath(subscriptionSid), params, new(eventsSubscribedEvent)); err != nil {
+				return fmt.Errorf("Failed to subscribe to %q: %s", eventType, err.Error())
+			}
+			continue
+		}
+
+		if subscribedEvent.SchemaVersion == schemaVersion {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"subscription_sid": subscriptionSid,
+				"type":             eventType,
+			},
+		).Debug("START client.Events.Subscriptions.SubscribedEvents.Update")
+
+		if err := client.UpdateResource(context, eventsSubscribedEventsPath(subscriptionSid), eventType, params, new(eventsSubscribedEvent)); err != nil {
+			return fmt.Errorf("Failed to update the schema version of %q: %s", eventType, err.Error())
+		}
+	}
+
+	for eventType := range existing {
+		if _, ok := versions[eventType]; ok {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"subscription_sid": subscriptionSid,
+				"type":             eventType,
+			},
+		).Debug("START client.Events.Subscriptions.SubscribedEvents.Delete")
+
+		if err := client.DeleteResource(context, eventsSubscribedEventsPath(subscriptionSid), eventType); err != nil {
+			return fmt.Errorf("Failed to unsubscribe from %q: %s", eventType, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// resourceTwilioEventsSubscriptionCustomizeDiff checks the subscribed types against the event types Twilio lists, so
+// that a misspelled type is reported during `terraform plan` rather than halfway through an apply.
+func resourceTwilioEventsSubscriptionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.HasChange("type") {
+		return nil
+	}
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	// Plans have no timeouts of their own, so the check gets the default.
+	context, cancel := context.WithTimeout(meta.(*TerraformTwilioContext).stopContext, defaultOperationTimeout)
+	defer cancel()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Events.Types.List")
+
+	eventsTypes, err := listEventsTypes(context, client, "")
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Events.Types.List failed")
+
+		return fmt.Errorf("Failed to list event types: %s", err.Error())
+	}
+
+	available := make(map[string]bool)
+	for _, eventType := range eventsTypes {
+		available[eventType.Type] = true
+	}
+
+	var unknown []string
+	for eventType := range eventsSubscribedEventVersions(d.Get("type").(*schema.Set)) {
+		if eventType != "" && !available[eventType] {
+			unknown = append(unknown, eventType)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("Unknown event types %s; the twilio_events_types data source lists the available types", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+func resourceTwilioEventsSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioEventsSubscriptionCreate")
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenEventsSubscriptionForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Events.Subscriptions.Create")
+
+	subscription := new(eventsSubscription)
+	err = client.CreateResource(context, eventsSubscriptionPathPart, createParams, subscription)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Events.Subscriptions.Create failed")
+
+		return err
+	}
+	d.SetId(subscription.Sid)
+
+	return resourceTwilioEventsSubscriptionRead(d, meta)
+}
+
+func resourceTwilioEventsSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioEventsSubscriptionRead")
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"subscription_sid": sid,
+		},
+	).Debug("START client.Events.Subscriptions.Get")
+
+	subscription := new(eventsSubscription)
+	err := client.GetResource(context, eventsSubscriptionPathPart, sid, subscription)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"subscription_sid": sid,
+			},
+		).Warn("events subscription no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"subscription_sid": sid,
+			},
+		).WithError(err).Error("client.Events.Subscriptions.Get failed")
+
+		return err
+	}
+	d.Set("sid", subscription.Sid)
+	d.Set("description", subscription.Description)
+	d.Set("sink_sid", subscription.SinkSid)
+
+	existing, err := listEventsSubscribedEvents(context, client, sid)
+	if err != nil {
+		return fmt.Errorf("Failed to list subscribed events: %s", err.Error())
+	}
+
+	types := make([]interface{}, 0, len(existing))
+	for _, subscribedEvent := range existing {
+		types = append(types, map[string]interface{}{
+			"type":           subscribedEvent.Type,
+			"schema_version": subscribedEvent.SchemaVersion,
+		})
+	}
+	d.Set("type", types)
+
+	return nil
+}
+
+func resourceTwilioEventsSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioEventsSubscriptionUpdate")
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+
+	if d.HasChange("description") || d.HasChange("sink_sid") {
+		updateParams, err := flattenEventsSubscriptionForUpdate(d)
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"subscription_sid": sid,
+			},
+		).Debug("START client.Events.Subscriptions.Update")
+
+		err = client.UpdateResource(context, eventsSubscriptionPathPart, sid, updateParams, new(eventsSubscription))
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid":      config.AccountSID,
+					"subscription_sid": sid,
+				},
+			).WithError(err).Error("client.Events.Subscriptions.Update failed")
+
+			return err
+		}
+	}
+
+	if d.HasChange("type") {
+		if err := reconcileEventsSubscribedEvents(context, client, sid, d.Get("type").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTwilioEventsSubscriptionRead(d, meta)
+}
+
+func resourceTwilioEventsSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioEventsSubscriptionDelete")
+
+	client := meta.(*TerraformTwilioContext).events
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"subscription_sid": sid,
+		},
+	).Debug("START client.Events.Subscriptions.Delete")
+
+	err := client.DeleteResource(context, eventsSubscriptionPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"subscription_sid": sid,
+		},
+	).Debug("END client.Events.Subscriptions.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete events subscription: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const messagingServicePathPart = "Services"
+
+// messagingService is a Twilio Messaging Service. For more documentation, see
+// https://www.twilio.com/docs/sms/services/api
+type messagingService struct {
+	Sid                       string               `json:"sid"`
+	AccountSid                string               `json:"account_sid"`
+	FriendlyName              string               `json:"friendly_name"`
+	InboundRequestURL         string               `json:"inbound_request_url"`
+	InboundMethod             string               `json:"inbound_method"`
+	FallbackURL               string               `json:"fallback_url"`
+	FallbackMethod            string               `json:"fallback_method"`
+	StatusCallback            string               `json:"status_callback"`
+	StickySender              bool                 `json:"sticky_sender"`
+	SmartEncoding             bool                 `json:"smart_encoding"`
+	MmsConverter              bool                 `json:"mms_converter"`
+	FallbackToLongCode        bool                 `json:"fallback_to_long_code"`
+	AreaCodeGeomatch          bool                 `json:"area_code_geomatch"`
+	ValidityPeriod            int                  `json:"validity_period"`
+	UseInboundWebhookOnNumber bool                 `json:"use_inbound_webhook_on_number"`
+	DateCreated               twiclient.TwilioTime `json:"date_created"`
+	DateUpdated               twiclient.TwilioTime `json:"date_updated"`
+}
+
+// messagingServiceParams are the parameters sent when a Messaging Service is created or updated.
+type messagingServiceParams struct {
+	FriendlyName              *string `terraform:"friendly_name" form:"FriendlyName"`
+	InboundRequestURL         *string `terraform:"inbound_request_url" form:"InboundRequestUrl"`
+	InboundMethod             *string `terraform:"inbound_method" form:"InboundMethod"`
+	FallbackURL               *string `terraform:"fallback_url" form:"FallbackUrl"`
+	FallbackMethod            *string `terraform:"fallback_method" form:"FallbackMethod"`
+	StatusCallback            *string `terraform:"status_callback" form:"StatusCallback"`
+	StickySender              *bool   `terraform:"sticky_sender" form:"StickySender"`
+	SmartEncoding             *bool   `terraform:"smart_encoding" form:"SmartEncoding"`
+	MmsConverter              *bool   `terraform:"mms_converter" form:"MmsConverter"`
+	FallbackToLongCode        *bool   `terraform:"fallback_to_long_code" form:"FallbackToLongCode"`
+	AreaCodeGeomatch          *bool   `terraform:"area_code_geomatch" form:"AreaCodeGeomatch"`
+	ValidityPeriod            *int    `terraform:"validity_period" form:"ValidityPeriod"`
+	UseInboundWebhookOnNumber *bool   `terraform:"use_inbound_webhook_on_number" form:"UseInboundWebhookOnNumber"`
+}
+
+func resourceTwilioMessagingService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioMessagingServiceCreate,
+		Read:   resourceTwilioMessagingServiceRead,
+		Update: resourceTwilioMessagingServiceUpdate,
+		Delete: resourceTwilioMessagingServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"inbound_request_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"inbound_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"fallback_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"fallback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"status_callback": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"sticky_sender": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"smart_encoding": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mms_converter": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"fallback_to_long_code": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"area_code_geomatch": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"validity_period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      14400,
+				ValidateFunc: validation.IntBetween(1, 14400),
+			},
+			"use_inbound_webhook_on_number": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func flattenMessagingServiceForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(messagingServiceParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func setMessagingServiceData(d *schema.ResourceData, service *messagingService) {
+	d.Set("sid", service.Sid)
+	d.Set("friendly_name", service.FriendlyName)
+	d.Set("inbound_request_url", service.InboundRequestURL)
+	d.Set("inbound_method", service.InboundMethod)
+	d.Set("fallback_url", service.FallbackURL)
+	d.Set("fallback_method", service.FallbackMethod)
+	d.Set("status_callback", service.StatusCallback)
+	d.Set("sticky_sender", service.StickySender)
+	d.Set("smart_encoding", service.SmartEncoding)
+	d.Set("mms_converter", service.MmsConverter)
+	d.Set("fallback_to_long_code", service.FallbackToLongCode)
+	d.Set("area_code_geomatch", service.AreaCodeGeomatch)
+	d.Set("validity_period", service.ValidityPeriod)
+	d.Set("use_inbound_webhook_on_number", service.UseInboundWebhookOnNumber)
+	d.Set("date_created", formatTwilioTime(service.DateCreated))
+	d.Set("date_updated", formatTwilioTime(service.DateUpdated))
+}
+
+func resourceTwilioMessagingServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceCreate")
+
+	client := meta.(*TerraformTwilioContext).messaging
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenMessagingServiceForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Messaging.Services.Create")
+
+	service := new(messagingService)
+	err = client.CreateResource(context, messagingServicePathPart, createParams, service)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Messaging.Services.Create failed")
+
+		return err
+	}
+	d.SetId(service.Sid)
+	setMessagingServiceData(d, service)
+	return nil
+}
+
+func resourceTwilioMessagingServiceRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceRead")
+
+	client := meta.(*TerraformTwilioContext).messaging
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": sid,
+		},
+	).Debug("START client.Messaging.Services.Get")
+
+	service := new(messagingService)
+	err := client.GetResource(context, messagingServicePathPart, sid, service)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": sid,
+			},
+		).Warn("messaging service no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": sid,
+			},
+		).WithError(err).Error("client.Messaging.Services.Get failed")
+
+		return err
+	}
+	setMessagingServiceData(d, service)
+	return nil
+}
+
+func resourceTwilioMessagingServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceUpdate")
+
+	client := meta.(*TerraformTwilioContext).messaging
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenMessagingServiceForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": sid,
+		},
+	).Debug("START client.Messaging.Services.Update")
+
+	service := new(messagingService)
+	err = client.UpdateResource(context, messagingServicePathPart, sid, updateParams, service)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": sid,
+			},
+		).WithError(err).Error("client.Messaging.Services.Update failed")
+
+		return err
+	}
+	setMessagingServiceData(d, service)
+	return nil
+}
+
+func resourceTwilioMessagingServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceDelete")
+
+	client := meta.(*TerraformTwilioContext).messaging
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": sid,
+		},
+	).Debug("START client.Messaging.Services.Delete")
+
+	err := client.DeleteResource(context, messagingServicePathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": sid,
+		},
+	).Debug("END client.Messaging.Services.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete messaging service: %s", err.Error())
+	}
+	return nil
+}
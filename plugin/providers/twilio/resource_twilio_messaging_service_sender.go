@@ -0,0 +1,94 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// messagingServicePhoneNumber adds a number bought on the account to a Messaging Service's sender pool. It is
+// identified by the number's SID. For more documentation, see
+// https://www.twilio.com/docs/messaging/services/api/phonenumber-resource
+type messagingServicePhoneNumber struct {
+	PhoneNumberSid string               `json:"sid" terraform:"phone_number_sid,required,forcenew" form:"PhoneNumberSid,omitempty"`
+	ServiceSid     string               `json:"service_sid" terraform:"service_sid"`
+	PhoneNumber    string               `json:"phone_number" terraform:"phone_number,computed"`
+	CountryCode    string               `json:"country_code" terraform:"country_code,computed"`
+	Capabilities   []string             `json:"capabilities" terraform:"capabilities,computed"`
+	DateCreated    twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+}
+
+// messagingServiceShortCode adds a short code on the account to a Messaging Service's sender pool. It is identified
+// by the short code's SID. For more documentation, see
+// https://www.twilio.com/docs/messaging/services/api/shortcode-resource
+type messagingServiceShortCode struct {
+	ShortCodeSid string               `json:"sid" terraform:"short_code_sid,required,forcenew" form:"ShortCodeSid,omitempty"`
+	ServiceSid   string               `json:"service_sid" terraform:"service_sid"`
+	ShortCode    string               `json:"short_code" terraform:"short_code,computed"`
+	CountryCode  string               `json:"country_code" terraform:"country_code,computed"`
+	Capabilities []string             `json:"capabilities" terraform:"capabilities,computed"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+}
+
+// messagingServiceAlphaSender adds an alphanumeric sender ID to a Messaging Service's sender pool. For more
+// documentation, see https://www.twilio.com/docs/messaging/services/api/alphasender-resource
+type messagingServiceAlphaSender struct {
+	Sid          string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid   string               `json:"service_sid" terraform:"service_sid"`
+	AlphaSender  string               `json:"alpha_sender" terraform:"alpha_sender,required,forcenew" form:"AlphaSender,omitempty"`
+	Capabilities []string             `json:"capabilities" terraform:"capabilities,computed"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+}
+
+func messagingClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.messaging
+}
+
+func resourceTwilioMessagingServicePhoneNumber() *schema.Resource {
+	r := &twilioResource{
+		name:        "Messaging.Services.PhoneNumbers",
+		description: "messaging service phone number",
+		client:      messagingClient,
+		pathPart:    messagingServicePathPart + "/{service_sid}/PhoneNumbers",
+		idAttribute: "phone_number_sid",
+		model: func() interface{} {
+			return new(messagingServicePhoneNumber)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioMessagingServiceShortCode() *schema.Resource {
+	r := &twilioResource{
+		name:        "Messaging.Services.ShortCodes",
+		description: "messaging service short code",
+		client:      messagingClient,
+		pathPart:    messagingServicePathPart + "/{service_sid}/ShortCodes",
+		idAttribute: "short_code_sid",
+		model: func() interface{} {
+			return new(messagingServiceShortCode)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioMessagingServiceAlphaSender() *schema.Resource {
+	r := &twilioResource{
+		name:        "Messaging.Services.AlphaSenders",
+		description: "messaging service alpha sender",
+		client:      messagingClient,
+		pathPart:    messagingServicePathPart + "/{service_sid}/AlphaSenders",
+		model: func() interface{} {
+			return new(messagingServiceAlphaSender)
+		},
+		schema: map[string]*schema.Schema{
+			"alpha_sender": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 11),
+			},
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,41 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// notifyCredential registers the credentials of a push notification provider (APNs, FCM or GCM) with Twilio. The
+// certificate, keys and secret are never returned, nor is the sandbox flag in a usable form, so they're write-only and
+// kept as configured. For more documentation, see https://www.twilio.com/docs/notify/api/credential-resource
+type notifyCredential struct {
+	Sid          string               `json:"sid" terraform:"sid,computed"`
+	AccountSid   string               `json:"account_sid" terraform:"account_sid,computed"`
+	Type         string               `json:"type" terraform:"type,required,forcenew,oneof=apn|fcm|gcm" form:"Type,omitempty"`
+	FriendlyName *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	Certificate  *string              `json:"-" terraform:"certificate,writeonly,sensitive" form:"Certificate"`
+	PrivateKey   *string              `json:"-" terraform:"private_key,writeonly,sensitive" form:"PrivateKey"`
+	Sandbox      *bool                `json:"-" terraform:"sandbox,writeonly" form:"Sandbox"`
+	APIKey       *string              `json:"-" terraform:"api_key,writeonly,sensitive" form:"ApiKey"`
+	Secret       *string              `json:"-" terraform:"secret,writeonly,sensitive" form:"Secret"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string               `json:"url" terraform:"url,computed"`
+}
+
+func notifyClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.notify
+}
+
+func resourceTwilioNotifyCredential() *schema.Resource {
+	r := &twilioResource{
+		name:        "Notify.Credentials",
+		description: "notify credential",
+		client:      notifyClient,
+		pathPart:    "Credentials",
+		model: func() interface{} {
+			return new(notifyCredential)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,40 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// notifyService is a Notify Service, which sends notifications through push credentials and a Messaging Service. For
+// more documentation, see https://www.twilio.com/docs/notify/api/service-resource
+type notifyService struct {
+	Sid                                   string               `json:"sid" terraform:"sid,computed"`
+	AccountSid                            string               `json:"account_sid" terraform:"account_sid,computed"`
+	FriendlyName                          *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	APNCredentialSid                      *string              `json:"apn_credential_sid" terraform:"apn_credential_sid" form:"ApnCredentialSid"`
+	FCMCredentialSid                      *string              `json:"fcm_credential_sid" terraform:"fcm_credential_sid" form:"FcmCredentialSid"`
+	GCMCredentialSid                      *string              `json:"gcm_credential_sid" terraform:"gcm_credential_sid" form:"GcmCredentialSid"`
+	MessagingServiceSid                   *string              `json:"messaging_service_sid" terraform:"messaging_service_sid" form:"MessagingServiceSid"`
+	DefaultAPNNotificationProtocolVersion *string              `json:"default_apn_notification_protocol_version" terraform:"default_apn_notification_protocol_version,optional,computed" form:"DefaultApnNotificationProtocolVersion"`
+	DefaultFCMNotificationProtocolVersion *string              `json:"default_fcm_notification_protocol_version" terraform:"default_fcm_notification_protocol_version,optional,computed" form:"DefaultFcmNotificationProtocolVersion"`
+	DefaultGCMNotificationProtocolVersion *string              `json:"default_gcm_notification_protocol_version" terraform:"default_gcm_notification_protocol_version,optional,computed" form:"DefaultGcmNotificationProtocolVersion"`
+	LogEnabled                            *bool                `json:"log_enabled" terraform:"log_enabled,optional,computed" form:"LogEnabled"`
+	DeliveryCallbackURL                   *string              `json:"delivery_callback_url" terraform:"delivery_callback_url,validate=url" form:"DeliveryCallbackUrl"`
+	DeliveryCallbackEnabled               *bool                `json:"delivery_callback_enabled" terraform:"delivery_callback_enabled,optional,computed" form:"DeliveryCallbackEnabled"`
+	DateCreated                           twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated                           twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                                   string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioNotifyService() *schema.Resource {
+	r := &twilioResource{
+		name:        "Notify.Services",
+		description: "notify service",
+		client:      notifyClient,
+		pathPart:    "Services",
+		model: func() interface{} {
+			return new(notifyService)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,62 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// proxyPhoneNumber adds a number bought on the account to a Proxy Service's pool. It is identified by the number's
+// SID. For more documentation, see https://www.twilio.com/docs/proxy/api/phone-number
+type proxyPhoneNumber struct {
+	PhoneNumberSid string                `json:"sid" terraform:"phone_number_sid,required,forcenew" form:"Sid,omitempty"`
+	ServiceSid     string                `json:"service_sid" terraform:"service_sid"`
+	PhoneNumber    twiclient.PhoneNumber `json:"phone_number" terraform:"phone_number,computed"`
+	FriendlyName   string                `json:"friendly_name" terraform:"friendly_name,computed"`
+	IsoCountry     string                `json:"iso_country" terraform:"iso_country,computed"`
+	IsReserved     *bool                 `json:"is_reserved" terraform:"is_reserved,optional,computed" form:"IsReserved"`
+	InUse          int                   `json:"in_use" terraform:"in_use,computed"`
+	DateCreated    twiclient.TwilioTime  `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated    twiclient.TwilioTime  `json:"date_updated" terraform:"date_updated,computed"`
+	URL            string                `json:"url" terraform:"url,computed"`
+}
+
+// proxyShortCode adds a short code on the account to a Proxy Service's pool. It is identified by the short code's
+// SID. For more documentation, see https://www.twilio.com/docs/proxy/api/short-code
+type proxyShortCode struct {
+	ShortCodeSid string               `json:"sid" terraform:"short_code_sid,required,forcenew" form:"Sid,omitempty"`
+	ServiceSid   string               `json:"service_sid" terraform:"service_sid"`
+	ShortCode    string               `json:"short_code" terraform:"short_code,computed"`
+	IsoCountry   string               `json:"iso_country" terraform:"iso_country,computed"`
+	IsReserved   *bool                `json:"is_reserved" terraform:"is_reserved,optional,computed" form:"IsReserved"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioProxyPhoneNumber() *schema.Resource {
+	r := &twilioResource{
+		name:        "Proxy.Service.PhoneNumbers",
+		description: "proxy phone number",
+		client:      proxyClient,
+		pathPart:    "Services/{service_sid}/PhoneNumbers",
+		idAttribute: "phone_number_sid",
+		model: func() interface{} {
+			return new(proxyPhoneNumber)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioProxyShortCode() *schema.Resource {
+	r := &twilioResource{
+		name:        "Proxy.Service.ShortCodes",
+		description: "proxy short code",
+		client:      proxyClient,
+		pathPart:    "Services/{service_sid}/ShortCodes",
+		idAttribute: "short_code_sid",
+		model: func() interface{} {
+			return new(proxyShortCode)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,252 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// proxyService is a Proxy Service, which masks the numbers of the participants in its sessions with numbers from its
+// pool. For more documentation, see https://www.twilio.com/docs/proxy/api/service
+type proxyService struct {
+	Sid                     string               `json:"sid" terraform:"sid,computed"`
+	AccountSid              string               `json:"account_sid" terraform:"account_sid,computed"`
+	UniqueName              string               `json:"unique_name" terraform:"unique_name,required" form:"UniqueName,omitempty"`
+	DefaultTTL              *int                 `json:"default_ttl" terraform:"default_ttl,optional,computed,min=0" form:"DefaultTtl"`
+	CallbackURL             *string              `json:"callback_url" terraform:"callback_url,validate=url" form:"CallbackUrl"`
+	InterceptCallbackURL    *string              `json:"intercept_callback_url" terraform:"intercept_callback_url,validate=url" form:"InterceptCallbackUrl"`
+	OutOfSessionCallbackURL *string              `json:"out_of_session_callback_url" terraform:"out_of_session_callback_url,validate=url" form:"OutOfSessionCallbackUrl"`
+	GeoMatchLevel           *string              `json:"geo_match_level" terraform:"geo_match_level,optional,computed,oneof=area-code|overlay|radius|country" form:"GeoMatchLevel"`
+	NumberSelectionBehavior *string              `json:"number_selection_behavior" terraform:"number_selection_behavior,optional,computed,oneof=avoid-sticky|prefer-sticky" form:"NumberSelectionBehavior"`
+	ChatInstanceSid         *string              `json:"chat_instance_sid" terraform:"chat_instance_sid" form:"ChatInstanceSid"`
+	DateCreated             twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated             twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                     string               `json:"url" terraform:"url,computed"`
+}
+
+// proxyPoolPage is a page of a Proxy Service's phone numbers or short codes; only the field for the listed collection
+// is filled in.
+type proxyPoolPage struct {
+	PhoneNumbers []*proxyPhoneNumber `json:"phone_numbers"`
+	ShortCodes   []*proxyShortCode   `json:"short_codes"`
+	Meta         twiclient.Meta      `json:"meta"`
+}
+
+func (p *proxyPoolPage) nextPage() string {
+	return p.Meta.NextPageURL.String
+}
+
+// proxyPool is a collection in a Proxy Service's pool and the attribute listing its SIDs.
+type proxyPool struct {
+	attribute  string
+	collection string
+	model      func() interface{}
+}
+
+var proxyPools = []proxyPool{
+	{
+		attribute:  "phone_number_sids",
+		collection: "PhoneNumbers",
+		model: func() interface{} {
+			return new(proxyPhoneNumber)
+		},
+	},
+	{
+		attribute:  "short_code_sids",
+		collection: "ShortCodes",
+		model: func() interface{} {
+			return new(proxyShortCode)
+		},
+	},
+}
+
+func proxyClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.proxy
+}
+
+// resourceTwilioProxyService manages a Proxy Service. Its pool can be managed here with `phone_number_sids` and
+// `short_code_sids`, which are reconciled as a whole, or one entry at a time with twilio_proxy_phone_number and
+// twilio_proxy_short_code; a service shouldn't use both.
+func resourceTwilioProxyService() *schema.Resource {
+	r := &twilioResource{
+		name:        "Proxy.Services",
+		description: "proxy service",
+		client:      proxyClient,
+		pathPart:    "Services",
+		model: func() interface{} {
+			return new(proxyService)
+		},
+		schema: map[string]*schema.Schema{
+			"phone_number_sids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"short_code_sids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	resource := r.build()
+
+	create := resource.Create
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		if err := updateProxyPools(d, meta, schema.TimeoutCreate); err != nil {
+			return err
+		}
+		return readProxyPools(d, meta)
+	}
+
+	read := resource.Read
+	resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+		return readProxyPools(d, meta)
+	}
+
+	update := resource.Update
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		if err := updateProxyPools(d, meta, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+		return readProxyPools(d, meta)
+	}
+
+	return resource
+}
+
+func proxyPoolPath(serviceSid string, collection string) string {
+	return fmt.Sprintf("Services/%s/%s", serviceSid, collection)
+}
+
+func listProxyPool(context context.Context, client *twiclient.Client, serviceSid string, pool proxyPool) (map[string]bool, error) {
+	sids := make(map[string]bool)
+	err := listAllPages(context, client, proxyPoolPath(serviceSid, pool.collection), url.Values{"PageSize": []string{"1000"}},
+		func() listPage {
+			return new(proxyPoolPage)
+		},
+		func(page listPage) {
+			for _, phoneNumber := range page.(*proxyPoolPage).PhoneNumbers {
+				sids[phoneNumber.PhoneNumberSid] = true
+			}
+			for _, shortCode := range page.(*proxyPoolPage).ShortCodes {
+				sids[shortCode.ShortCodeSid] = true
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sids, nil
+}
+
+// reconcileProxyPool adds and removes phone numbers or short codes so that the pool matches the configuration. New
+// entries are added before any are removed, so that the pool isn't left empty while sessions are open.
+func reconcileProxyPool(context context.Context, client *twiclient.Client, serviceSid string, pool proxyPool, sids *schema.Set) error {
+	existing, err := listProxyPool(context, client, serviceSid, pool)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool)
+	for _, raw := range sids.List() {
+		sid := raw.(string)
+		wanted[sid] = true
+		if existing[sid] {
+			continue
+		}
+
+		params := make(url.Values)
+		params.Add("Sid", sid)
+
+		log.WithFields(
+			log.Fields{
+				"service_sid": serviceSid,
+				"sid":         sid,
+			},
+		).Debugf("START client.Proxy.Services.%s.Create", pool.collection)
+
+		if err := client.CreateResource(context, proxyPoolPath(serviceSid, pool.collection), params, pool.model()); err != nil {
+			return fmt.Errorf("Failed to add %s to the proxy service: %s", sid, err.Error())
+		}
+	}
+
+	for sid := range existing {
+		if wanted[sid] {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"service_sid": serviceSid,
+				"sid":         sid,
+			},
+		).Debugf("START client.Proxy.Services.%s.Delete", pool.collection)
+
+		if err := client.DeleteResource(context, proxyPoolPath(serviceSid, pool.collection), sid); err != nil {
+			return fmt.Errorf("Failed to remove %s from the proxy service: %s", sid, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// updateProxyPools reconciles the pools that are configured and have changed, including those configured as empty. A
+// pool left out of the configuration isn't managed, as the attributes are also computed.
+func updateProxyPools(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	client := meta.(*TerraformTwilioContext).proxy
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, timeoutKey)
+	defer cancel()
+
+	for _, pool := range proxyPools {
+		if !d.HasChange(pool.attribute) {
+			continue
+		}
+		if _, ok := d.GetOkExists(pool.attribute); !ok {
+			continue
+		}
+		if err := reconcileProxyPool(context, client, d.Id(), pool, d.Get(pool.attribute).(*schema.Set)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readProxyPools(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*TerraformTwilioContext).proxy
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	for _, pool := range proxyPools {
+		existing, err := listProxyPool(context, client, d.Id(), pool)
+		if err != nil {
+			return fmt.Errorf("Failed to read proxy service %s: %s", pool.attribute, err.Error())
+		}
+
+		var sids []interface{}
+		for sid := range existing {
+			sids = append(sids, sid)
+		}
+		d.Set(pool.attribute, schema.NewSet(schema.HashString, sids))
+	}
+	return nil
+}
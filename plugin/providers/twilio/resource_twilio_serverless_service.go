@@ -1,23 +1,55 @@
 package twilio
 
 import (
-	"context"
-	"fmt"
-	"net/url"
+	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	log "github.com/sirupsen/logrus"
+	twiclient "github.com/kaiquelupo/twilio-go"
 )
 
+// serverlessService is a Serverless Service, the container for Functions and Assets. For more documentation, see
+// https://www.twilio.com/docs/runtime/functions-assets-api/api/service
+type serverlessService struct {
+	Sid                string               `json:"sid" terraform:"sid,computed"`
+	UniqueName         string               `json:"unique_name" terraform:"unique_name,required,forcenew" form:"UniqueName,omitempty"`
+	FriendlyName       string               `json:"friendly_name" terraform:"friendly_name,required" form:"FriendlyName,omitempty"`
+	IncludeCredentials *bool                `json:"include_credentials" terraform:"include_credentials,optional,computed" form:"IncludeCredentials"`
+	UIEditable         *bool                `json:"ui_editable" terraform:"ui_editable,optional,computed" form:"UiEditable"`
+	DateCreated        twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated        twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                string               `json:"url" terraform:"url,computed"`
+}
+
 func resourceTwilioServerlessService() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTwilioServerlessServiceCreate,
-		Read:   resourceTwilioServerlessServiceRead,
-		Update: resourceTwilioServerlessServiceUpdate,
-		Delete: resourceTwilioServerlessServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+	r := &twilioResource{
+		name:        "Serverless.Services",
+		description: "serverless service",
+		client: func(c *TerraformTwilioContext) *twiclient.Client {
+			return c.client.Serverless
+		},
+		pathPart: "Services",
+		model: func() interface{} {
+			return new(serverlessService)
 		},
+	}
+	resource := r.build()
+
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceTwilioServerlessServiceV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceTwilioServerlessServiceStateUpgradeV0,
+		},
+	}
+
+	return resource
+}
+
+// resourceTwilioServerlessServiceV0 is the schema before the service moved onto the resource builder, when
+// `include_credentials` and `ui_editable` were strings.
+func resourceTwilioServerlessServiceV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"sid": &schema.Schema{
 				Type:     schema.TypeString,
@@ -43,140 +75,19 @@ func resourceTwilioServerlessService() *schema.Resource {
 	}
 }
 
-func flattenServerlessServiceForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
-
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-	v.Add("UniqueName", d.Get("unique_name").(string))
-	v.Add("IncludeCredentials", d.Get("include_credentials").(string))
-	v.Add("UiEditable", d.Get("ui_editable").(string))
-
-	
-	return v
-}
-
-func resourceTwilioServerlessServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioServerlessServiceCreate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	createParams := flattenServerlessServiceForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.Serverless.Service")
-
-	service, err := client.Serverless.Create(context, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.Serverless.Service failed")
-
-		return err
-	}
-	d.SetId(service.Sid)
-	d.Set("friendly_name", service.FriendlyName)
-	d.Set("unique_name", service.UniqueName)
-
-	return nil
-}
-
-func resourceTwilioServerlessServiceRead(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioServerlessServiceRead")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.Serverless.Service.Get")
-
-	service, err := client.Serverless.Get(context, sid)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"parent_account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.Serverless.Service.Get failed")
-
-		return err
-	}
-	d.Set("friendly_name", service.FriendlyName)
-	d.Set("unique_name", service.UniqueName)
-	return nil
-}
-
-func resourceTwilioServerlessServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioServerlessServiceUpdate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	createParams := flattenServerlessServiceForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.Serverless.Update")
-
-	service, err := client.Serverless.Update(context, sid, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.Serverless.Update failed")
-
-		return err
-	}
-	d.SetId(service.Sid)
-	d.Set("friendly_name", service.FriendlyName)
-	d.Set("unique_name", service.UniqueName)
-	
-	return nil
-}
-
-func resourceTwilioServerlessServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioServerlessServiceDelete")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
-		},
-	).Debug("START client.Serverless.Delete")
-
-	err := client.Serverless.Delete(context, sid)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
-		},
-	).Debug("END client.Serverless.Delete")
-	
-	if err != nil {
-		return fmt.Errorf("Failed to delete serverless service: %s", err.Error())
+// resourceTwilioServerlessServiceStateUpgradeV0 turns the string flags into booleans. Values that aren't booleans are
+// dropped, and read back from Twilio on the next refresh.
+func resourceTwilioServerlessServiceStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	for _, attribute := range []string{"include_credentials", "ui_editable"} {
+		value, ok := rawState[attribute].(string)
+		if !ok {
+			continue
+		}
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			rawState[attribute] = parsed
+		} else {
+			delete(rawState, attribute)
+		}
 	}
-	return nil
+	return rawState, nil
 }
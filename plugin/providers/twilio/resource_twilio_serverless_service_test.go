@@ -0,0 +1,23 @@
+package twilio
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Serverless Service", func() {
+	table.DescribeTable("state upgrade from v0",
+		func(rawState map[string]interface{}, expected map[string]interface{}) {
+			upgraded, err := resourceTwilioServerlessServiceStateUpgradeV0(rawState, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upgraded).To(Equal(expected))
+		},
+		table.Entry("string flags",
+			map[string]interface{}{"id": "ZS123", "include_credentials": "true", "ui_editable": "false"},
+			map[string]interface{}{"id": "ZS123", "include_credentials": true, "ui_editable": false}),
+		table.Entry("unset flags",
+			map[string]interface{}{"id": "ZS123", "include_credentials": "", "ui_editable": nil},
+			map[string]interface{}{"id": "ZS123", "ui_editable": nil}),
+	)
+})
@@ -0,0 +1,374 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const sipCredentialListPathPart = "SIP/CredentialLists"
+
+// sipCredentialList is a list of SIP credentials. For more documentation, see
+// https://www.twilio.com/docs/voice/sip/api/sip-credentiallist-resource
+type sipCredentialList struct {
+	Sid          string               `json:"sid"`
+	AccountSid   string               `json:"account_sid"`
+	FriendlyName string               `json:"friendly_name"`
+	DateCreated  twiclient.TwilioTime `json:"date_created"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated"`
+}
+
+// sipCredentialListParams are the parameters sent when a credential list is created or updated.
+type sipCredentialListParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+}
+
+// sipCredential is a username/password pair in a credential list. Twilio never returns the password.
+type sipCredential struct {
+	Sid      string `json:"sid"`
+	Username string `json:"username"`
+}
+
+type sipCredentialPage struct {
+	twiclient.Page
+	Credentials []*sipCredential `json:"credentials"`
+}
+
+func (p *sipCredentialPage) nextPage() string {
+	return p.NextPageURI.String
+}
+
+func resourceTwilioSipCredentialList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSipCredentialListCreate,
+		Read:   resourceTwilioSipCredentialListRead,
+		Update: resourceTwilioSipCredentialListUpdate,
+		Delete: resourceTwilioSipCredentialListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"credential": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				// Credentials are identified by their username, as in reconcileSipCredentials.
+				Set: mapper.HashcodeByKeys("username"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenSipCredentialListForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(sipCredentialListParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func sipCredentialsPath(credentialListSid string) string {
+	return fmt.Sprintf("%s/%s/Credentials", sipCredentialListPathPart, credentialListSid)
+}
+
+// sipCredentialPasswords maps usernames to passwords for a set of `credential` blocks.
+func sipCredentialPasswords(credentials *schema.Set) map[string]string {
+	passwords := make(map[string]string)
+	for _, raw := range credentials.List() {
+		credential := raw.(map[string]interface{})
+		passwords[credential["username"].(string)] = credential["password"].(string)
+	}
+	return passwords
+}
+
+func listSipCredentials(context context.Context, client *twiclient.Client, credentialListSid string) (map[string]*sipCredential, error) {
+	credentials := make(map[string]*sipCredential)
+	err := listAllPages(context, client, sipCredentialsPath(credentialListSid), url.Values{"PageSize": []string{"1000"}},
+		func() listPage {
+			return new(sipCredentialPage)
+		},
+		func(page listPage) {
+			for _, credential := range page.(*sipCredentialPage).Credentials {
+				credentials[credential.Username] = credential
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// reconcileSipCredentials creates, updates and removes credentials so that the list matches the configuration. As
+// passwords can't be read back, a password change is detected from the configuration alone.
+func reconcileSipCredentials(context context.Context, client *twiclient.Client, credentialListSid string, oldCredentials *schema.Set, newCredentials *schema.Set) error {
+	oldPasswords := sipCredentialPasswords(oldCredentials)
+	newPasswords := sipCredentialPasswords(newCredentials)
+
+	existing, err := listSipCredentials(context, client, credentialListSid)
+	if err != nil {
+		return err
+	}
+
+	for username, credential := range existing {
+		if _, ok := newPasswords[username]; ok {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"credential_list_sid": credentialListSid,
+				"credential_sid":      credential.Sid,
+			},
+		).Debug("START client.SIP.CredentialLists.Credentials.Delete")
+
+		if err := client.DeleteResource(context, sipCredentialsPath(credentialListSid), credential.Sid); err != nil {
+			return fmt.Errorf("Failed to delete SIP credential %q: %s", username, err.Error())
+		}
+	}
+
+	for username, password := range newPasswords {
+		params := make(url.Values)
+		params.Add("Password", password)
+
+		credential, ok := existing[username]
+		if !ok {
+			params.Add("Username", username)
+
+			log.WithFields(
+				log.Fields{
+					"credential_list_sid": credentialListSid,
+				},
+			).Debug("START client.SIP.CredentialLists.Credentials.Create")
+
+			if err := client.CreateResource(context, sipCredentialsPath(credentialListSid), params, new(sipCredential)); err != nil {
+				return fmt.Errorf("Failed to create SIP credential %q: %s", username, err.Error())
+			}
+			continue
+		}
+
+		if oldPassword, ok := oldPasswords[username]; ok && oldPassword == password {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"credential_list_sid": credentialListSid,
+				"credential_sid":      credential.Sid,
+			},
+		).Debug("START client.SIP.CredentialLists.Credentials.Update")
+
+		if err := client.UpdateResource(context, sipCredentialsPath(credentialListSid), credential.Sid, params, new(sipCredential)); err != nil {
+			return fmt.Errorf("Failed to update SIP credential %q: %s", username, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourceTwilioSipCredentialListCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipCredentialListCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenSipCredentialListForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.SIP.CredentialLists.Create")
+
+	credentialList := new(sipCredentialList)
+	err = client.CreateResource(context, sipCredentialListPathPart, createParams, credentialList)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.SIP.CredentialLists.Create failed")
+
+		return err
+	}
+	d.SetId(credentialList.Sid)
+	d.Set("sid", credentialList.Sid)
+	d.Set("friendly_name", credentialList.FriendlyName)
+
+	credentials := d.Get("credential").(*schema.Set)
+	if err := reconcileSipCredentials(context, client, credentialList.Sid, schema.NewSet(credentials.F, nil), credentials); err != nil {
+		return err
+	}
+
+	return resourceTwilioSipCredentialListRead(d, meta)
+}
+
+func resourceTwilioSipCredentialListRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipCredentialListRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":         config.AccountSID,
+			"credential_list_sid": sid,
+		},
+	).Debug("START client.SIP.CredentialLists.Get")
+
+	credentialList := new(sipCredentialList)
+	err := client.GetResource(context, sipCredentialListPathPart, sid, credentialList)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid":         config.AccountSID,
+				"credential_list_sid": sid,
+			},
+		).Warn("SIP credential list no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":         config.AccountSID,
+				"credential_list_sid": sid,
+			},
+		).WithError(err).Error("client.SIP.CredentialLists.Get failed")
+
+		return err
+	}
+	d.Set("sid", credentialList.Sid)
+	d.Set("friendly_name", credentialList.FriendlyName)
+
+	existing, err := listSipCredentials(context, client, sid)
+	if err != nil {
+		return fmt.Errorf("Failed to list SIP credentials: %s", err.Error())
+	}
+
+	// Passwords are write-only, so the configured value is carried over for every username that still exists.
+	passwords := sipCredentialPasswords(d.Get("credential").(*schema.Set))
+	credentials := make([]interface{}, 0, len(existing))
+	for username := range existing {
+		credentials = append(credentials, map[string]interface{}{
+			"username": username,
+			"password": passwords[username],
+		})
+	}
+	d.Set("credential", credentials)
+
+	return nil
+}
+
+func resourceTwilioSipCredentialListUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipCredentialListUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+
+	if d.HasChange("friendly_name") {
+		updateParams, err := flattenSipCredentialListForCreate(d)
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":         config.AccountSID,
+				"credential_list_sid": sid,
+			},
+		).Debug("START client.SIP.CredentialLists.Update")
+
+		err = client.UpdateResource(context, sipCredentialListPathPart, sid, updateParams, new(sipCredentialList))
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid":         config.AccountSID,
+					"credential_list_sid": sid,
+				},
+			).WithError(err).Error("client.SIP.CredentialLists.Update failed")
+
+			return err
+		}
+	}
+
+	if d.HasChange("credential") {
+		oldCredentials, newCredentials := d.GetChange("credential")
+		if err := reconcileSipCredentials(context, client, sid, oldCredentials.(*schema.Set), newCredentials.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTwilioSipCredentialListRead(d, meta)
+}
+
+func resourceTwilioSipCredentialListDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipCredentialListDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":         config.AccountSID,
+			"credential_list_sid": sid,
+		},
+	).Debug("START client.SIP.CredentialLists.Delete")
+
+	err := client.DeleteResource(context, sipCredentialListPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":         config.AccountSID,
+			"credential_list_sid": sid,
+		},
+	).Debug("END client.SIP.CredentialLists.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete SIP credential list: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const sipDomainPathPart = "SIP/Domains"
+
+// sipDomain is a Programmable Voice SIP Domain. For more documentation, see
+// https://www.twilio.com/docs/voice/sip/api/sip-domain-resource
+type sipDomain struct {
+	Sid                       string               `json:"sid"`
+	AccountSid                string               `json:"account_sid"`
+	DomainName                string               `json:"domain_name"`
+	FriendlyName              string               `json:"friendly_name"`
+	VoiceURL                  string               `json:"voice_url"`
+	VoiceMethod               string               `json:"voice_method"`
+	VoiceFallbackURL          string               `json:"voice_fallback_url"`
+	VoiceFallbackMethod       string               `json:"voice_fallback_method"`
+	VoiceStatusCallbackURL    string               `json:"voice_status_callback_url"`
+	VoiceStatusCallbackMethod string               `json:"voice_status_callback_method"`
+	SipRegistration           bool                 `json:"sip_registration"`
+	EmergencyCallingEnabled   bool                 `json:"emergency_calling_enabled"`
+	Secure                    bool                 `json:"secure"`
+	DateCreated               twiclient.TwilioTime `json:"date_created"`
+	DateUpdated               twiclient.TwilioTime `json:"date_updated"`
+}
+
+// sipDomainParams are the parameters sent when a SIP Domain is created or updated.
+type sipDomainParams struct {
+	DomainName                *string `terraform:"domain_name" form:"DomainName"`
+	FriendlyName              *string `terraform:"friendly_name" form:"FriendlyName"`
+	VoiceURL                  *string `terraform:"voice_url" form:"VoiceUrl"`
+	VoiceMethod               *string `terraform:"voice_method" form:"VoiceMethod"`
+	VoiceFallbackURL          *string `terraform:"voice_fallback_url" form:"VoiceFallbackUrl"`
+	VoiceFallbackMethod       *string `terraform:"voice_fallback_method" form:"VoiceFallbackMethod"`
+	VoiceStatusCallbackURL    *string `terraform:"voice_status_callback_url" form:"VoiceStatusCallbackUrl"`
+	VoiceStatusCallbackMethod *string `terraform:"voice_status_callback_method" form:"VoiceStatusCallbackMethod"`
+	SipRegistration           *bool   `terraform:"sip_registration" form:"SipRegistration"`
+	EmergencyCallingEnabled   *bool   `terraform:"emergency_calling_enabled" form:"EmergencyCallingEnabled"`
+	Secure                    *bool   `terraform:"secure" form:"Secure"`
+}
+
+func resourceTwilioSipDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSipDomainCreate,
+		Read:   resourceTwilioSipDomainRead,
+		Update: resourceTwilioSipDomainUpdate,
+		Delete: resourceTwilioSipDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"voice_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"voice_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"voice_fallback_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"voice_fallback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"voice_status_callback_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"voice_status_callback_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"sip_registration": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"emergency_calling_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"secure": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func flattenSipDomainForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(sipDomainParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func setSipDomainData(d *schema.ResourceData, domain *sipDomain) {
+	d.Set("sid", domain.Sid)
+	d.Set("domain_name", domain.DomainName)
+	d.Set("friendly_name", domain.FriendlyName)
+	d.Set("voice_url", domain.VoiceURL)
+	d.Set("voice_method", domain.VoiceMethod)
+	d.Set("voice_fallback_url", domain.VoiceFallbackURL)
+	d.Set("voice_fallback_method", domain.VoiceFallbackMethod)
+	d.Set("voice_status_callback_url", domain.VoiceStatusCallbackURL)
+	d.Set("voice_status_callback_method", domain.VoiceStatusCallbackMethod)
+	d.Set("sip_registration", domain.SipRegistration)
+	d.Set("emergency_calling_enabled", domain.EmergencyCallingEnabled)
+	d.Set("secure", domain.Secure)
+	d.Set("date_created", formatTwilioTime(domain.DateCreated))
+	d.Set("date_updated", formatTwilioTime(domain.DateUpdated))
+}
+
+func resourceTwilioSipDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipDomainCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenSipDomainForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.SIP.Domains.Create")
+
+	domain := new(sipDomain)
+	err = client.CreateResource(context, sipDomainPathPart, createParams, domain)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.SIP.Domains.Create failed")
+
+		return err
+	}
+	d.SetId(domain.Sid)
+	setSipDomainData(d, domain)
+	return nil
+}
+
+func resourceTwilioSipDomainRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipDomainRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"domain_sid":  sid,
+		},
+	).Debug("START client.SIP.Domains.Get")
+
+	domain := new(sipDomain)
+	err := client.GetResource(context, sipDomainPathPart, sid, domain)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"domain_sid":  sid,
+			},
+		).Warn("SIP domain no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"domain_sid":  sid,
+			},
+		).WithError(err).Error("client.SIP.Domains.Get failed")
+
+		return err
+	}
+	setSipDomainData(d, domain)
+	return nil
+}
+
+func resourceTwilioSipDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipDomainUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenSipDomainForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"domain_sid":  sid,
+		},
+	).Debug("START client.SIP.Domains.Update")
+
+	domain := new(sipDomain)
+	err = client.UpdateResource(context, sipDomainPathPart, sid, updateParams, domain)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"domain_sid":  sid,
+			},
+		).WithError(err).Error("client.SIP.Domains.Update failed")
+
+		return err
+	}
+	setSipDomainData(d, domain)
+	return nil
+}
+
+func resourceTwilioSipDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipDomainDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"domain_sid":  sid,
+		},
+	).Debug("START client.SIP.Domains.Delete")
+
+	err := client.DeleteResource(context, sipDomainPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"domain_sid":  sid,
+		},
+	).Debug("END client.SIP.Domains.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete SIP domain: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// sipDomainCredentialListMapping maps a credential list to a SIP Domain for calls. It is identified by the list's SID.
+// For more documentation, see https://www.twilio.com/docs/voice/sip/api/sip-domain-resource
+type sipDomainCredentialListMapping struct {
+	CredentialListSid string `json:"sid" terraform:"credential_list_sid,required,forcenew" form:"CredentialListSid,omitempty"`
+	FriendlyName      string `json:"friendly_name" terraform:"friendly_name,computed"`
+}
+
+// sipDomainIPAccessControlListMapping maps an IP access control list to a SIP Domain. It is identified by the list's
+// SID.
+type sipDomainIPAccessControlListMapping struct {
+	IPAccessControlListSid string `json:"sid" terraform:"ip_access_control_list_sid,required,forcenew" form:"IpAccessControlListSid,omitempty"`
+	FriendlyName           string `json:"friendly_name" terraform:"friendly_name,computed"`
+}
+
+func accountClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.client
+}
+
+func resourceTwilioSipDomainCredentialListMapping() *schema.Resource {
+	r := &twilioResource{
+		name:        "SIP.Domains.CredentialListMappings",
+		description: "SIP domain credential list mapping",
+		client:      accountClient,
+		pathPart:    sipDomainPathPart + "/{domain_sid}/CredentialListMappings",
+		idAttribute: "credential_list_sid",
+		model: func() interface{} {
+			return new(sipDomainCredentialListMapping)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioSipDomainIPAccessControlListMapping() *schema.Resource {
+	r := &twilioResource{
+		name:        "SIP.Domains.IpAccessControlListMappings",
+		description: "SIP domain IP access control list mapping",
+		client:      accountClient,
+		pathPart:    sipDomainPathPart + "/{domain_sid}/IpAccessControlListMappings",
+		idAttribute: "ip_access_control_list_sid",
+		model: func() interface{} {
+			return new(sipDomainIPAccessControlListMapping)
+		},
+	}
+	return r.build()
+}
+
+// resourceTwilioSipDomainRegistrationCredentialListMapping maps a credential list used to authenticate SIP
+// registrations, rather than calls, to a SIP Domain.
+func resourceTwilioSipDomainRegistrationCredentialListMapping() *schema.Resource {
+	r := &twilioResource{
+		name:        "SIP.Domains.Auth.Registrations.CredentialListMappings",
+		description: "SIP domain registration credential list mapping",
+		client:      accountClient,
+		pathPart:    sipDomainPathPart + "/{domain_sid}/Auth/Registrations/CredentialListMappings",
+		idAttribute: "credential_list_sid",
+		model: func() interface{} {
+			return new(sipDomainCredentialListMapping)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,382 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const sipIPAccessControlListPathPart = "SIP/IpAccessControlLists"
+
+// sipIPAccessControlList is a list of IP ranges allowed to reach a SIP Domain or trunk. For more documentation, see
+// https://www.twilio.com/docs/voice/sip/api/sip-ipaccesscontrollist-resource
+type sipIPAccessControlList struct {
+	Sid          string               `json:"sid"`
+	AccountSid   string               `json:"account_sid"`
+	FriendlyName string               `json:"friendly_name"`
+	DateCreated  twiclient.TwilioTime `json:"date_created"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated"`
+}
+
+// sipIPAccessControlListParams are the parameters sent when an IP access control list is created or updated.
+type sipIPAccessControlListParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+}
+
+// sipIPAddress is an entry in an IP access control list.
+type sipIPAddress struct {
+	Sid              string `json:"sid"`
+	FriendlyName     string `json:"friendly_name"`
+	IPAddress        string `json:"ip_address"`
+	CidrPrefixLength int    `json:"cidr_prefix_length"`
+}
+
+type sipIPAddressPage struct {
+	twiclient.Page
+	IPAddresses []*sipIPAddress `json:"ip_addresses"`
+}
+
+func (p *sipIPAddressPage) nextPage() string {
+	return p.NextPageURI.String
+}
+
+func (a *sipIPAddress) cidr() string {
+	return fmt.Sprintf("%s/%d", a.IPAddress, a.CidrPrefixLength)
+}
+
+func resourceTwilioSipIPAccessControlList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSipIPAccessControlListCreate,
+		Read:   resourceTwilioSipIPAccessControlListRead,
+		Update: resourceTwilioSipIPAccessControlListUpdate,
+		Delete: resourceTwilioSipIPAccessControlListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip_address": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				// Entries are identified by their range, as in reconcileSipIPAddresses.
+				Set: mapper.HashcodeByKeys("cidr"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"friendly_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cidr": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(8, 32),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenSipIPAccessControlListForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(sipIPAccessControlListParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func sipIPAddressesPath(ipAccessControlListSid string) string {
+	return fmt.Sprintf("%s/%s/IpAddresses", sipIPAccessControlListPathPart, ipAccessControlListSid)
+}
+
+// sipIPAddressNames maps CIDR ranges to friendly names for a set of `ip_address` blocks.
+func sipIPAddressNames(ipAddresses *schema.Set) map[string]string {
+	names := make(map[string]string)
+	for _, raw := range ipAddresses.List() {
+		ipAddress := raw.(map[string]interface{})
+		names[ipAddress["cidr"].(string)] = ipAddress["friendly_name"].(string)
+	}
+	return names
+}
+
+func listSipIPAddresses(context context.Context, client *twiclient.Client, ipAccessControlListSid string) (map[string]*sipIPAddress, error) {
+	ipAddresses := make(map[string]*sipIPAddress)
+	err := listAllPages(context, client, sipIPAddressesPath(ipAccessControlListSid), url.Values{"PageSize": []string{"1000"}},
+		func() listPage {
+			return new(sipIPAddressPage)
+		},
+		func(page listPage) {
+			for _, ipAddress := range page.(*sipIPAddressPage).IPAddresses {
+				ipAddresses[ipAddress.cidr()] = ipAddress
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ipAddresses, nil
+}
+
+// reconcileSipIPAddresses creates, renames and removes entries so that the list matches the configuration.
+func reconcileSipIPAddresses(context context.Context, client *twiclient.Client, ipAccessControlListSid string, ipAddresses *schema.Set) error {
+	names := sipIPAddressNames(ipAddresses)
+
+	existing, err := listSipIPAddresses(context, client, ipAccessControlListSid)
+	if err != nil {
+		return err
+	}
+
+	for cidr, ipAddress := range existing {
+		if _, ok := names[cidr]; ok {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"ip_access_control_list_sid": ipAccessControlListSid,
+				"ip_address_sid":             ipAddress.Sid,
+			},
+		).Debug("START client.SIP.IpAccessControlLists.IpAddresses.Delete")
+
+		if err := client.DeleteResource(context, sipIPAddressesPath(ipAccessControlListSid), ipAddress.Sid); err != nil {
+			return fmt.Errorf("Failed to delete IP address %s: %s", cidr, err.Error())
+		}
+	}
+
+	for cidr, friendlyName := range names {
+		params := make(url.Values)
+		params.Add("FriendlyName", friendlyName)
+
+		ipAddress, ok := existing[cidr]
+		if !ok {
+			ip, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return fmt.Errorf("Invalid CIDR %s: %s", cidr, err.Error())
+			}
+			prefixLength, _ := network.Mask.Size()
+
+			params.Add("IpAddress", ip.String())
+			params.Add("CidrPrefixLength", strconv.Itoa(prefixLength))
+
+			log.WithFields(
+				log.Fields{
+					"ip_access_control_list_sid": ipAccessControlListSid,
+				},
+			).Debug("START client.SIP.IpAccessControlLists.IpAddresses.Create")
+
+			if err := client.CreateResource(context, sipIPAddressesPath(ipAccessControlListSid), params, new(sipIPAddress)); err != nil {
+				return fmt.Errorf("Failed to create IP address %s: %s", cidr, err.Error())
+			}
+			continue
+		}
+
+		if ipAddress.FriendlyName == friendlyName {
+			continue
+		}
+
+		log.WithFields(
+			log.Fields{
+				"ip_access_control_list_sid": ipAccessControlListSid,
+				"ip_address_sid":             ipAddress.Sid,
+			},
+		).Debug("START client.SIP.IpAccessControlLists.IpAddresses.Update")
+
+		if err := client.UpdateResource(context, sipIPAddressesPath(ipAccessControlListSid), ipAddress.Sid, params, new(sipIPAddress)); err != nil {
+			return fmt.Errorf("Failed to update IP address %s: %s", cidr, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourceTwilioSipIPAccessControlListCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipIPAccessControlListCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenSipIPAccessControlListForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.SIP.IpAccessControlLists.Create")
+
+	ipAccessControlList := new(sipIPAccessControlList)
+	err = client.CreateResource(context, sipIPAccessControlListPathPart, createParams, ipAccessControlList)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.SIP.IpAccessControlLists.Create failed")
+
+		return err
+	}
+	d.SetId(ipAccessControlList.Sid)
+
+	if err := reconcileSipIPAddresses(context, client, ipAccessControlList.Sid, d.Get("ip_address").(*schema.Set)); err != nil {
+		return err
+	}
+
+	return resourceTwilioSipIPAccessControlListRead(d, meta)
+}
+
+func resourceTwilioSipIPAccessControlListRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipIPAccessControlListRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":                config.AccountSID,
+			"ip_access_control_list_sid": sid,
+		},
+	).Debug("START client.SIP.IpAccessControlLists.Get")
+
+	ipAccessControlList := new(sipIPAccessControlList)
+	err := client.GetResource(context, sipIPAccessControlListPathPart, sid, ipAccessControlList)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid":                config.AccountSID,
+				"ip_access_control_list_sid": sid,
+			},
+		).Warn("SIP IP access control list no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":                config.AccountSID,
+				"ip_access_control_list_sid": sid,
+			},
+		).WithError(err).Error("client.SIP.IpAccessControlLists.Get failed")
+
+		return err
+	}
+	d.Set("sid", ipAccessControlList.Sid)
+	d.Set("friendly_name", ipAccessControlList.FriendlyName)
+
+	existing, err := listSipIPAddresses(context, client, sid)
+	if err != nil {
+		return fmt.Errorf("Failed to list IP addresses: %s", err.Error())
+	}
+
+	ipAddresses := make([]interface{}, 0, len(existing))
+	for cidr, ipAddress := range existing {
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"friendly_name": ipAddress.FriendlyName,
+			"cidr":          cidr,
+		})
+	}
+	d.Set("ip_address", ipAddresses)
+
+	return nil
+}
+
+func resourceTwilioSipIPAccessControlListUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipIPAccessControlListUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+
+	if d.HasChange("friendly_name") {
+		updateParams, err := flattenSipIPAccessControlListForCreate(d)
+		if err != nil {
+			return err
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":                config.AccountSID,
+				"ip_access_control_list_sid": sid,
+			},
+		).Debug("START client.SIP.IpAccessControlLists.Update")
+
+		err = client.UpdateResource(context, sipIPAccessControlListPathPart, sid, updateParams, new(sipIPAccessControlList))
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid":                config.AccountSID,
+					"ip_access_control_list_sid": sid,
+				},
+			).WithError(err).Error("client.SIP.IpAccessControlLists.Update failed")
+
+			return err
+		}
+	}
+
+	if d.HasChange("ip_address") {
+		if err := reconcileSipIPAddresses(context, client, sid, d.Get("ip_address").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTwilioSipIPAccessControlListRead(d, meta)
+}
+
+func resourceTwilioSipIPAccessControlListDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipIPAccessControlListDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":                config.AccountSID,
+			"ip_access_control_list_sid": sid,
+		},
+	).Debug("START client.SIP.IpAccessControlLists.Delete")
+
+	err := client.DeleteResource(context, sipIPAccessControlListPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":                config.AccountSID,
+			"ip_access_control_list_sid": sid,
+		},
+	).Debug("END client.SIP.IpAccessControlLists.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete IP access control list: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const sipTrunkPathPart = "Trunks"
+
+// sipTrunk is an Elastic SIP Trunk. For more documentation, see
+// https://www.twilio.com/docs/sip-trunking/api/trunk-resource
+type sipTrunk struct {
+	Sid                    string               `json:"sid"`
+	AccountSid             string               `json:"account_sid"`
+	FriendlyName           string               `json:"friendly_name"`
+	DomainName             string               `json:"domain_name"`
+	DisasterRecoveryURL    string               `json:"disaster_recovery_url"`
+	DisasterRecoveryMethod string               `json:"disaster_recovery_method"`
+	TransferMode           string               `json:"transfer_mode"`
+	Secure                 bool                 `json:"secure"`
+	CnamLookupEnabled      bool                 `json:"cnam_lookup_enabled"`
+	Recording              sipTrunkRecording    `json:"recording"`
+	DateCreated            twiclient.TwilioTime `json:"date_created"`
+	DateUpdated            twiclient.TwilioTime `json:"date_updated"`
+}
+
+// sipTrunkParams are the parameters sent when a trunk is created or updated.
+type sipTrunkParams struct {
+	FriendlyName           *string `terraform:"friendly_name" form:"FriendlyName"`
+	DomainName             *string `terraform:"domain_name" form:"DomainName"`
+	Secure                 *bool   `terraform:"secure" form:"Secure"`
+	CnamLookupEnabled      *bool   `terraform:"cnam_lookup_enabled" form:"CnamLookupEnabled"`
+	DisasterRecoveryURL    *string `terraform:"disaster_recovery_url" form:"DisasterRecoveryUrl"`
+	DisasterRecoveryMethod *string `terraform:"disaster_recovery_method" form:"DisasterRecoveryMethod"`
+	TransferMode           *string `terraform:"transfer_mode" form:"TransferMode"`
+}
+
+// sipTrunkRecording is the recording configuration of a trunk, which is managed through its own sub-resource.
+type sipTrunkRecording struct {
+	Mode string `json:"mode"`
+	Trim string `json:"trim"`
+}
+
+func resourceTwilioSipTrunk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSipTrunkCreate,
+		Read:   resourceTwilioSipTrunkRead,
+		Update: resourceTwilioSipTrunkUpdate,
+		Delete: resourceTwilioSipTrunkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secure": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cnam_lookup_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"disaster_recovery_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+			},
+			"disaster_recovery_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validateHTTPMethod,
+			},
+			"transfer_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disable-all",
+				ValidateFunc: validation.StringInSlice([]string{"disable-all", "enable-all", "sip-only"}, false),
+			},
+			"recording_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "do-not-record",
+				ValidateFunc: validation.StringInSlice([]string{
+					"do-not-record",
+					"record-from-ringing",
+					"record-from-answer",
+					"record-from-ringing-dual",
+					"record-from-answer-dual",
+				}, false),
+			},
+			"recording_trim": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "do-not-trim",
+				ValidateFunc: validation.StringInSlice([]string{"trim-silence", "do-not-trim"}, false),
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func flattenSipTrunkForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(sipTrunkParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func flattenSipTrunkRecording(d *schema.ResourceData) url.Values {
+	v := make(url.Values)
+
+	v.Add("Mode", d.Get("recording_mode").(string))
+	v.Add("Trim", d.Get("recording_trim").(string))
+
+	return v
+}
+
+func setSipTrunkData(d *schema.ResourceData, trunk *sipTrunk) {
+	d.Set("sid", trunk.Sid)
+	d.Set("friendly_name", trunk.FriendlyName)
+	d.Set("domain_name", trunk.DomainName)
+	d.Set("secure", trunk.Secure)
+	d.Set("cnam_lookup_enabled", trunk.CnamLookupEnabled)
+	d.Set("disaster_recovery_url", trunk.DisasterRecoveryURL)
+	d.Set("disaster_recovery_method", trunk.DisasterRecoveryMethod)
+	d.Set("transfer_mode", trunk.TransferMode)
+	d.Set("recording_mode", trunk.Recording.Mode)
+	d.Set("recording_trim", trunk.Recording.Trim)
+	d.Set("date_created", formatTwilioTime(trunk.DateCreated))
+	d.Set("date_updated", formatTwilioTime(trunk.DateUpdated))
+}
+
+// updateSipTrunkRecording applies the recording settings, which can't be passed when creating or updating the trunk.
+func updateSipTrunkRecording(context context.Context, client *twiclient.Client, d *schema.ResourceData, trunk *sipTrunk) error {
+	recordingParams := flattenSipTrunkRecording(d)
+
+	log.WithFields(
+		log.Fields{
+			"trunk_sid": trunk.Sid,
+		},
+	).Debug("START client.Trunking.Trunks.Recording.Update")
+
+	err := client.UpdateResource(context, sipTrunkPathPart, trunk.Sid+"/Recording", recordingParams, &trunk.Recording)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"trunk_sid": trunk.Sid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.Recording.Update failed")
+
+		return err
+	}
+	return nil
+}
+
+func resourceTwilioSipTrunkCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkCreate")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenSipTrunkForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Trunking.Trunks.Create")
+
+	trunk := new(sipTrunk)
+	err = client.CreateResource(context, sipTrunkPathPart, createParams, trunk)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.Create failed")
+
+		return err
+	}
+	d.SetId(trunk.Sid)
+
+	if err := updateSipTrunkRecording(context, client, d, trunk); err != nil {
+		return err
+	}
+
+	setSipTrunkData(d, trunk)
+	return nil
+}
+
+func resourceTwilioSipTrunkRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkRead")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   sid,
+		},
+	).Debug("START client.Trunking.Trunks.Get")
+
+	trunk := new(sipTrunk)
+	err := client.GetResource(context, sipTrunkPathPart, sid, trunk)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   sid,
+			},
+		).Warn("SIP trunk no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   sid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.Get failed")
+
+		return err
+	}
+	setSipTrunkData(d, trunk)
+	return nil
+}
+
+func resourceTwilioSipTrunkUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkUpdate")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenSipTrunkForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   sid,
+		},
+	).Debug("START client.Trunking.Trunks.Update")
+
+	trunk := new(sipTrunk)
+	err = client.UpdateResource(context, sipTrunkPathPart, sid, updateParams, trunk)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   sid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.Update failed")
+
+		return err
+	}
+
+	if d.HasChange("recording_mode") || d.HasChange("recording_trim") {
+		if err := updateSipTrunkRecording(context, client, d, trunk); err != nil {
+			return err
+		}
+	}
+
+	setSipTrunkData(d, trunk)
+	return nil
+}
+
+func resourceTwilioSipTrunkDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkDelete")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   sid,
+		},
+	).Debug("START client.Trunking.Trunks.Delete")
+
+	err := client.DeleteResource(context, sipTrunkPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   sid,
+		},
+	).Debug("END client.Trunking.Trunks.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete SIP trunk: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// sipTrunkIPAccessControlList associates an IP access control list with a trunk. It is identified by the list's SID.
+// For more documentation, see https://www.twilio.com/docs/sip-trunking/api/ipaccesscontrollist-resource
+type sipTrunkIPAccessControlList struct {
+	IPAccessControlListSid string `json:"sid" terraform:"ip_access_control_list_sid,required,forcenew" form:"IpAccessControlListSid,omitempty"`
+	TrunkSid               string `json:"trunk_sid" terraform:"trunk_sid"`
+	FriendlyName           string `json:"friendly_name" terraform:"friendly_name,computed"`
+}
+
+// sipTrunkCredentialList associates a credential list with a trunk. It is identified by the list's SID. For more
+// documentation, see https://www.twilio.com/docs/sip-trunking/api/credentiallist-resource
+type sipTrunkCredentialList struct {
+	CredentialListSid string `json:"sid" terraform:"credential_list_sid,required,forcenew" form:"CredentialListSid,omitempty"`
+	TrunkSid          string `json:"trunk_sid" terraform:"trunk_sid"`
+	FriendlyName      string `json:"friendly_name" terraform:"friendly_name,computed"`
+}
+
+// sipTrunkPhoneNumber associates a number bought on the account with a trunk. It is identified by the number's SID.
+// For more documentation, see https://www.twilio.com/docs/sip-trunking/api/phonenumber-resource
+type sipTrunkPhoneNumber struct {
+	PhoneNumberSid string `json:"sid" terraform:"phone_number_sid,required,forcenew" form:"PhoneNumberSid,omitempty"`
+	TrunkSid       string `json:"trunk_sid" terraform:"trunk_sid"`
+	FriendlyName   string `json:"friendly_name" terraform:"friendly_name,computed"`
+}
+
+func trunkingClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.trunking
+}
+
+func resourceTwilioSipTrunkIPAccessControlList() *schema.Resource {
+	r := &twilioResource{
+		name:        "Trunking.Trunks.IpAccessControlLists",
+		description: "SIP trunk IP access control list",
+		client:      trunkingClient,
+		pathPart:    sipTrunkPathPart + "/{trunk_sid}/IpAccessControlLists",
+		idAttribute: "ip_access_control_list_sid",
+		model: func() interface{} {
+			return new(sipTrunkIPAccessControlList)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioSipTrunkCredentialList() *schema.Resource {
+	r := &twilioResource{
+		name:        "Trunking.Trunks.CredentialLists",
+		description: "SIP trunk credential list",
+		client:      trunkingClient,
+		pathPart:    sipTrunkPathPart + "/{trunk_sid}/CredentialLists",
+		idAttribute: "credential_list_sid",
+		model: func() interface{} {
+			return new(sipTrunkCredentialList)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioSipTrunkPhoneNumber() *schema.Resource {
+	r := &twilioResource{
+		name:        "Trunking.Trunks.PhoneNumbers",
+		description: "SIP trunk phone number",
+		client:      trunkingClient,
+		pathPart:    sipTrunkPathPart + "/{trunk_sid}/PhoneNumbers",
+		idAttribute: "phone_number_sid",
+		model: func() interface{} {
+			return new(sipTrunkPhoneNumber)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,260 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+// sipTrunkOriginationURL is a SIP endpoint that a trunk routes inbound calls to. For more documentation, see
+// https://www.twilio.com/docs/sip-trunking/api/originationurl-resource
+type sipTrunkOriginationURL struct {
+	Sid          string               `json:"sid"`
+	AccountSid   string               `json:"account_sid"`
+	TrunkSid     string               `json:"trunk_sid"`
+	FriendlyName string               `json:"friendly_name"`
+	SipURL       string               `json:"sip_url"`
+	Priority     int                  `json:"priority"`
+	Weight       int                  `json:"weight"`
+	Enabled      bool                 `json:"enabled"`
+	DateCreated  twiclient.TwilioTime `json:"date_created"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated"`
+}
+
+// sipTrunkOriginationURLParams are the parameters sent when an origination URL is created or updated.
+type sipTrunkOriginationURLParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+	SipURL       *string `terraform:"sip_url" form:"SipUrl"`
+	Priority     *int    `terraform:"priority" form:"Priority"`
+	Weight       *int    `terraform:"weight" form:"Weight"`
+	Enabled      *bool   `terraform:"enabled" form:"Enabled"`
+}
+
+func resourceTwilioSipTrunkOriginationURL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSipTrunkOriginationURLCreate,
+		Read:   resourceTwilioSipTrunkOriginationURLRead,
+		Update: resourceTwilioSipTrunkOriginationURLUpdate,
+		Delete: resourceTwilioSipTrunkOriginationURLDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateWithParentSid("trunk_sid"),
+		},
+		Timeouts: defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trunk_sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sip_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func flattenSipTrunkOriginationURLForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(sipTrunkOriginationURLParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
+}
+
+func setSipTrunkOriginationURLData(d *schema.ResourceData, originationURL *sipTrunkOriginationURL) {
+	d.Set("sid", originationURL.Sid)
+	d.Set("trunk_sid", originationURL.TrunkSid)
+	d.Set("friendly_name", originationURL.FriendlyName)
+	d.Set("sip_url", originationURL.SipURL)
+	d.Set("priority", originationURL.Priority)
+	d.Set("weight", originationURL.Weight)
+	d.Set("enabled", originationURL.Enabled)
+}
+
+func sipTrunkOriginationURLPath(trunkSid string) string {
+	return fmt.Sprintf("%s/%s/OriginationUrls", sipTrunkPathPart, trunkSid)
+}
+
+func resourceTwilioSipTrunkOriginationURLCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkOriginationURLCreate")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	trunkSid := d.Get("trunk_sid").(string)
+	createParams, err := flattenSipTrunkOriginationURLForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   trunkSid,
+		},
+	).Debug("START client.Trunking.Trunks.OriginationUrls.Create")
+
+	originationURL := new(sipTrunkOriginationURL)
+	err = client.CreateResource(context, sipTrunkOriginationURLPath(trunkSid), createParams, originationURL)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   trunkSid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.OriginationUrls.Create failed")
+
+		return err
+	}
+	d.SetId(originationURL.Sid)
+	setSipTrunkOriginationURLData(d, originationURL)
+	return nil
+}
+
+func resourceTwilioSipTrunkOriginationURLRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkOriginationURLRead")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+	trunkSid := d.Get("trunk_sid").(string)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   trunkSid,
+		},
+	).Debug("START client.Trunking.Trunks.OriginationUrls.Get")
+
+	originationURL := new(sipTrunkOriginationURL)
+	err := client.GetResource(context, sipTrunkOriginationURLPath(trunkSid), sid, originationURL)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   trunkSid,
+			},
+		).Warn("SIP trunk origination URL no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   trunkSid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.OriginationUrls.Get failed")
+
+		return err
+	}
+	setSipTrunkOriginationURLData(d, originationURL)
+	return nil
+}
+
+func resourceTwilioSipTrunkOriginationURLUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkOriginationURLUpdate")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	trunkSid := d.Get("trunk_sid").(string)
+	updateParams, err := flattenSipTrunkOriginationURLForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   trunkSid,
+		},
+	).Debug("START client.Trunking.Trunks.OriginationUrls.Update")
+
+	originationURL := new(sipTrunkOriginationURL)
+	err = client.UpdateResource(context, sipTrunkOriginationURLPath(trunkSid), sid, updateParams, originationURL)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"trunk_sid":   trunkSid,
+			},
+		).WithError(err).Error("client.Trunking.Trunks.OriginationUrls.Update failed")
+
+		return err
+	}
+	setSipTrunkOriginationURLData(d, originationURL)
+	return nil
+}
+
+func resourceTwilioSipTrunkOriginationURLDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSipTrunkOriginationURLDelete")
+
+	client := meta.(*TerraformTwilioContext).trunking
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+	trunkSid := d.Get("trunk_sid").(string)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   trunkSid,
+		},
+	).Debug("START client.Trunking.Trunks.OriginationUrls.Delete")
+
+	err := client.DeleteResource(context, sipTrunkOriginationURLPath(trunkSid), sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"trunk_sid":   trunkSid,
+		},
+	).Debug("END client.Trunking.Trunks.OriginationUrls.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete SIP trunk origination URL: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
+	twiclient "github.com/kaiquelupo/twilio-go"
+	log "github.com/sirupsen/logrus"
+)
+
+const studioFlowPathPart = "Flows"
+
+// studioFlow is a Twilio Studio Flow. For more documentation, see
+// https://www.twilio.com/docs/studio/rest-api/v2/flow
+type studioFlow struct {
+	Sid           string               `json:"sid"`
+	AccountSid    string               `json:"account_sid"`
+	FriendlyName  string               `json:"friendly_name"`
+	Definition    json.RawMessage      `json:"definition"`
+	Status        string               `json:"status"`
+	Revision      int                  `json:"revision"`
+	CommitMessage string               `json:"commit_message"`
+	Valid         bool                 `json:"valid"`
+	WebhookURL    string               `json:"webhook_url"`
+	DateCreated   twiclient.TwilioTime `json:"date_created"`
+	DateUpdated   twiclient.TwilioTime `json:"date_updated"`
+}
+
+// studioFlowParams are the parameters sent when a flow is created, updated or validated. Twilio requires the friendly
+// name, definition and status on every call, so only the commit message is left out when empty.
+type studioFlowParams struct {
+	FriendlyName  string `form:"FriendlyName"`
+	Definition    string `form:"Definition"`
+	Status        string `form:"Status"`
+	CommitMessage string `form:"CommitMessage,omitempty"`
+}
+
+// studioFlowValidation is the result of the Studio FlowValidate endpoint.
+type studioFlowValidation struct {
+	Valid bool `json:"valid"`
+}
+
+func resourceTwilioStudioFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioStudioFlowCreate,
+		Read:   resourceTwilioStudioFlowRead,
+		Update: resourceTwilioStudioFlowUpdate,
+		Delete: resourceTwilioStudioFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceTwilioStudioFlowCustomizeDiff,
+		Timeouts:      defaultResourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"sid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"definition": jsonattr.Attribute(&schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			}),
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "draft",
+				ValidateFunc: validation.StringInSlice([]string{"draft", "published"}, false),
+			},
+			// Sent with the revisions the apply creates. It isn't read back, so that a message isn't carried over to
+			// later revisions once it is removed from the configuration.
+			"commit_message": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"revision": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"valid": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"webhook_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// studioFlowConfig is satisfied by both *schema.ResourceData and *schema.ResourceDiff so that the same parameters can
+// be sent to FlowValidate during plan and to the Flows endpoint during apply.
+type studioFlowConfig interface {
+	Get(string) interface{}
+}
+
+func flattenStudioFlowForCreate(d studioFlowConfig) (url.Values, error) {
+	return mapper.MarshalToURLValues(&studioFlowParams{
+		FriendlyName:  d.Get("friendly_name").(string),
+		Definition:    d.Get("definition").(string),
+		Status:        d.Get("status").(string),
+		CommitMessage: d.Get("commit_message").(string),
+	})
+}
+
+func setStudioFlowData(d *schema.ResourceData, flow *studioFlow) {
+	d.Set("sid", flow.Sid)
+	d.Set("friendly_name", flow.FriendlyName)
+	d.Set("definition", string(flow.Definition))
+	d.Set("status", flow.Status)
+	d.Set("revision", flow.Revision)
+	d.Set("valid", flow.Valid)
+	d.Set("webhook_url", flow.WebhookURL)
+	d.Set("date_created", formatTwilioTime(flow.DateCreated))
+	d.Set("date_updated", formatTwilioTime(flow.DateUpdated))
+}
+
+// resourceTwilioStudioFlowCustomizeDiff runs the planned flow through Studio's FlowValidate endpoint, so that broken
+// widgets are reported during `terraform plan` rather than halfway through an apply.
+func resourceTwilioStudioFlowCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("definition") || !d.NewValueKnown("friendly_name") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("definition") && !d.HasChange("status") && !d.HasChange("friendly_name") {
+		return nil
+	}
+
+	client := meta.(*TerraformTwilioContext).studio
+	config := meta.(*TerraformTwilioContext).configuration
+	// Plans have no timeouts of their own, so validation gets the default.
+	context, cancel := context.WithTimeout(meta.(*TerraformTwilioContext).stopContext, defaultOperationTimeout)
+	defer cancel()
+
+	validateParams, err := flattenStudioFlowForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Studio.Flows.Validate")
+
+	result := new(studioFlowValidation)
+	err = client.CreateResource(context, studioFlowPathPart+"/Validate", validateParams, result)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Studio.Flows.Validate failed")
+
+		return fmt.Errorf("Studio flow definition is invalid: %s", err.Error())
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("Studio flow definition is invalid")
+	}
+
+	return nil
+}
+
+func resourceTwilioStudioFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioStudioFlowCreate")
+
+	client := meta.(*TerraformTwilioContext).studio
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
+
+	createParams, err := flattenStudioFlowForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Studio.Flows.Create")
+
+	flow := new(studioFlow)
+	err = client.CreateResource(context, studioFlowPathPart, createParams, flow)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("client.Studio.Flows.Create failed")
+
+		return err
+	}
+	d.SetId(flow.Sid)
+	setStudioFlowData(d, flow)
+	return nil
+}
+
+func resourceTwilioStudioFlowRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioStudioFlowRead")
+
+	client := meta.(*TerraformTwilioContext).studio
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"flow_sid":    sid,
+		},
+	).Debug("START client.Studio.Flows.Get")
+
+	flow := new(studioFlow)
+	err := client.GetResource(context, studioFlowPathPart, sid, flow)
+	if isNotFound(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"flow_sid":    sid,
+			},
+		).Warn("studio flow no longer exists, removing it from the state")
+
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"flow_sid":    sid,
+			},
+		).WithError(err).Error("client.Studio.Flows.Get failed")
+
+		return err
+	}
+	setStudioFlowData(d, flow)
+	return nil
+}
+
+func resourceTwilioStudioFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioStudioFlowUpdate")
+
+	client := meta.(*TerraformTwilioContext).studio
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	sid := d.Id()
+	updateParams, err := flattenStudioFlowForCreate(d)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"flow_sid":    sid,
+		},
+	).Debug("START client.Studio.Flows.Update")
+
+	flow := new(studioFlow)
+	err = client.UpdateResource(context, studioFlowPathPart, sid, updateParams, flow)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"flow_sid":    sid,
+			},
+		).WithError(err).Error("client.Studio.Flows.Update failed")
+
+		return err
+	}
+	setStudioFlowData(d, flow)
+	return nil
+}
+
+func resourceTwilioStudioFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioStudioFlowDelete")
+
+	client := meta.(*TerraformTwilioContext).studio
+	config := meta.(*TerraformTwilioContext).configuration
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"flow_sid":    sid,
+		},
+	).Debug("START client.Studio.Flows.Delete")
+
+	err := client.DeleteResource(context, studioFlowPathPart, sid)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"flow_sid":    sid,
+		},
+	).Debug("END client.Studio.Flows.Delete")
+	if err != nil {
+		return fmt.Errorf("Failed to delete studio flow: %s", err.Error())
+	}
+	return nil
+}
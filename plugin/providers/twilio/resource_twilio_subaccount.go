@@ -1,16 +1,21 @@
 package twilio
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/url"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/mapper"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// subaccountParams are the parameters sent when a subaccount is created.
+type subaccountParams struct {
+	FriendlyName *string `terraform:"friendly_name" form:"FriendlyName"`
+}
+
 func resourceTwilioSubaccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTwilioSubaccountCreate,
@@ -21,6 +26,7 @@ func resourceTwilioSubaccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: defaultResourceTimeouts(),
 		Schema: map[string]*schema.Schema{
 			"parent_account_sid": &schema.Schema{
 				Type:     schema.TypeString,
@@ -51,12 +57,12 @@ func resourceTwilioSubaccount() *schema.Resource {
 	}
 }
 
-func flattenSubaccountForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
-
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-
-	return v
+func flattenSubaccountForCreate(d *schema.ResourceData) (url.Values, error) {
+	params := new(subaccountParams)
+	if err := mapper.UnmarshalFromTerraform(d, params); err != nil {
+		return nil, err
+	}
+	return mapper.MarshalToURLValues(params)
 }
 
 func flattenSubaccountForDelete(d *schema.ResourceData) url.Values {
@@ -72,9 +78,13 @@ func resourceTwilioSubaccountCreate(d *schema.ResourceData, meta interface{}) er
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutCreate)
+	defer cancel()
 
-	createParams := flattenSubaccountForCreate(d)
+	createParams, err := flattenSubaccountForCreate(d)
+	if err != nil {
+		return err
+	}
 
 	log.WithFields(
 		log.Fields{
@@ -98,8 +108,8 @@ func resourceTwilioSubaccountCreate(d *schema.ResourceData, meta interface{}) er
 	d.Set("status", createResult.Status)
 	d.Set("auth_token", createResult.AuthToken)
 	d.Set("friendly_name", createResult.FriendlyName) // In the event that the name wasn't specified, Twilio generates one for you
-	d.Set("date_created", createResult.DateCreated)
-	d.Set("date_updated", createResult.DateUpdated)
+	d.Set("date_created", formatTwilioTime(createResult.DateCreated))
+	d.Set("date_updated", formatTwilioTime(createResult.DateUpdated))
 	d.Set("parent_account_sid", createResult.OwnerAccountSid)
 
 	log.WithFields(
@@ -117,7 +127,8 @@ func resourceTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) erro
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutRead)
+	defer cancel()
 
 	sid := d.Id()
 
@@ -133,8 +144,8 @@ func resourceTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("status", account.Status)
 	d.Set("auth_token", account.AuthToken)
 	d.Set("friendly_name", account.FriendlyName) // In the event that the name wasn't specified, Twilio generates one for you
-	d.Set("date_created", account.DateCreated)
-	d.Set("date_updated", account.DateUpdated)
+	d.Set("date_created", formatTwilioTime(account.DateCreated))
+	d.Set("date_updated", formatTwilioTime(account.DateUpdated))
 	d.Set("parent_account_sid", account.OwnerAccountSid)
 
 	log.WithFields(
@@ -160,7 +171,8 @@ func resourceTwilioSubaccountDelete(d *schema.ResourceData, meta interface{}) er
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
+	context, cancel := meta.(*TerraformTwilioContext).operationContext(d, schema.TimeoutDelete)
+	defer cancel()
 
 	sid := d.Id()
 
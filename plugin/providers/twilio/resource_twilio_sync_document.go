@@ -0,0 +1,35 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// syncDocument is a Sync Document, a JSON object shared between clients. Twilio only returns when a document expires,
+// so its `ttl` is kept as configured. For more documentation, see https://www.twilio.com/docs/sync/api/document-resource
+type syncDocument struct {
+	Sid         string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	UniqueName  string               `json:"unique_name" terraform:"unique_name,optional,computed,forcenew" form:"UniqueName,omitempty"`
+	Data        *jsonattr.Document   `json:"data" terraform:"data,optional,computed,json" form:"Data"`
+	TTL         *int                 `json:"-" terraform:"ttl,writeonly,min=0" form:"Ttl"`
+	Revision    string               `json:"revision" terraform:"revision,computed"`
+	DateExpires twiclient.TwilioTime `json:"date_expires" terraform:"date_expires,computed"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioSyncDocument() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Service.Documents",
+		description: "sync document",
+		client:      syncClient,
+		pathPart:    "Services/{service_sid}/Documents",
+		model: func() interface{} {
+			return new(syncDocument)
+		},
+	}
+	return r.build()
+}
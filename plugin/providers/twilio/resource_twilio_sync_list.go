@@ -0,0 +1,63 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// syncList is a Sync List, an ordered collection of JSON items. For more documentation, see
+// https://www.twilio.com/docs/sync/api/list-resource
+type syncList struct {
+	Sid         string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	UniqueName  string               `json:"unique_name" terraform:"unique_name,optional,computed,forcenew" form:"UniqueName,omitempty"`
+	TTL         *int                 `json:"-" terraform:"ttl,writeonly,min=0" form:"Ttl"`
+	Revision    string               `json:"revision" terraform:"revision,computed"`
+	DateExpires twiclient.TwilioTime `json:"date_expires" terraform:"date_expires,computed"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+// syncListItem is an item in a Sync List, used to seed it. Items are appended in the order they're created and
+// identified by their index. For more documentation, see https://www.twilio.com/docs/sync/api/listitem-resource
+type syncListItem struct {
+	Index       int                  `json:"index" terraform:"index,computed"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	ListSid     string               `json:"list_sid" terraform:"list_sid"`
+	Data        jsonattr.Document    `json:"data" terraform:"data,required,json" form:"Data,omitempty"`
+	TTL         *int                 `json:"-" terraform:"ttl,writeonly,min=0" form:"Ttl"`
+	Revision    string               `json:"revision" terraform:"revision,computed"`
+	DateExpires twiclient.TwilioTime `json:"date_expires" terraform:"date_expires,computed"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioSyncList() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Service.Lists",
+		description: "sync list",
+		client:      syncClient,
+		pathPart:    "Services/{service_sid}/Lists",
+		model: func() interface{} {
+			return new(syncList)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioSyncListItem() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Service.List.Items",
+		description: "sync list item",
+		client:      syncClient,
+		pathPart:    "Services/{service_sid}/Lists/{list_sid}/Items",
+		idAttribute: "index",
+		model: func() interface{} {
+			return new(syncListItem)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,63 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kaiquelupo/terraform-provider-twilio/helpers/jsonattr"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// syncMap is a Sync Map, a collection of JSON items identified by key. For more documentation, see
+// https://www.twilio.com/docs/sync/api/map-resource
+type syncMap struct {
+	Sid         string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	UniqueName  string               `json:"unique_name" terraform:"unique_name,optional,computed,forcenew" form:"UniqueName,omitempty"`
+	TTL         *int                 `json:"-" terraform:"ttl,writeonly,min=0" form:"Ttl"`
+	Revision    string               `json:"revision" terraform:"revision,computed"`
+	DateExpires twiclient.TwilioTime `json:"date_expires" terraform:"date_expires,computed"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+// syncMapItem is an item in a Sync Map, used to seed it. For more documentation, see
+// https://www.twilio.com/docs/sync/api/map-item-resource
+type syncMapItem struct {
+	Key         string               `json:"key" terraform:"key,required,forcenew" form:"Key,omitempty"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	MapSid      string               `json:"map_sid" terraform:"map_sid"`
+	Data        jsonattr.Document    `json:"data" terraform:"data,required,json" form:"Data,omitempty"`
+	TTL         *int                 `json:"-" terraform:"ttl,writeonly,min=0" form:"Ttl"`
+	Revision    string               `json:"revision" terraform:"revision,computed"`
+	DateExpires twiclient.TwilioTime `json:"date_expires" terraform:"date_expires,computed"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioSyncMap() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Service.Maps",
+		description: "sync map",
+		client:      syncClient,
+		pathPart:    "Services/{service_sid}/Maps",
+		model: func() interface{} {
+			return new(syncMap)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioSyncMapItem() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Service.Map.Items",
+		description: "sync map item",
+		client:      syncClient,
+		pathPart:    "Services/{service_sid}/Maps/{map_sid}/Items",
+		idAttribute: "key",
+		model: func() interface{} {
+			return new(syncMapItem)
+		},
+	}
+	return r.build()
+}
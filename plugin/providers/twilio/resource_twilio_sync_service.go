@@ -0,0 +1,41 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// syncService is a Sync Service, which holds documents, lists, maps and streams. For more documentation, see
+// https://www.twilio.com/docs/sync/api/service
+type syncService struct {
+	Sid                           string               `json:"sid" terraform:"sid,computed"`
+	AccountSid                    string               `json:"account_sid" terraform:"account_sid,computed"`
+	UniqueName                    string               `json:"unique_name" terraform:"unique_name,computed"`
+	FriendlyName                  *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	WebhookURL                    *string              `json:"webhook_url" terraform:"webhook_url,validate=url" form:"WebhookUrl"`
+	WebhooksFromRestEnabled       *bool                `json:"webhooks_from_rest_enabled" terraform:"webhooks_from_rest_enabled,optional,computed" form:"WebhooksFromRestEnabled"`
+	ReachabilityWebhooksEnabled   *bool                `json:"reachability_webhooks_enabled" terraform:"reachability_webhooks_enabled,optional,computed" form:"ReachabilityWebhooksEnabled"`
+	ReachabilityDebouncingEnabled *bool                `json:"reachability_debouncing_enabled" terraform:"reachability_debouncing_enabled,optional,computed" form:"ReachabilityDebouncingEnabled"`
+	ReachabilityDebouncingWindow  *int                 `json:"reachability_debouncing_window" terraform:"reachability_debouncing_window,optional,computed,min=1000,max=30000" form:"ReachabilityDebouncingWindow"`
+	ACLEnabled                    *bool                `json:"acl_enabled" terraform:"acl_enabled,optional,computed" form:"AclEnabled"`
+	DateCreated                   twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated                   twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                           string               `json:"url" terraform:"url,computed"`
+}
+
+func syncClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.sync
+}
+
+func resourceTwilioSyncService() *schema.Resource {
+	r := &twilioResource{
+		name:        "Sync.Services",
+		description: "sync service",
+		client:      syncClient,
+		pathPart:    "Services",
+		model: func() interface{} {
+			return new(syncService)
+		},
+	}
+	return r.build()
+}
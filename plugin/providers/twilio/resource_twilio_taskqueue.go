@@ -1,189 +1,37 @@
 package twilio
 
 import (
-	"context"
-	"fmt"
-	"net/url"
-
 	"github.com/hashicorp/terraform/helper/schema"
-	log "github.com/sirupsen/logrus"
+	twiclient "github.com/kaiquelupo/twilio-go"
 )
 
-func resourceTwilioTaskQueue() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTwilioTaskQueueCreate,
-		Read:   resourceTwilioTaskQueueRead,
-		Update: resourceTwilioTaskQueueUpdate,
-		Delete: resourceTwilioTaskQueueDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: map[string]*schema.Schema{
-			"sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"workspace_sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"friendly_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"target_workers": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-		},
-	}
+// taskRouterTaskQueue is a TaskRouter TaskQueue. For more documentation, see
+// https://www.twilio.com/docs/taskrouter/api/task-queue
+type taskRouterTaskQueue struct {
+	Sid                    string               `json:"sid" terraform:"sid,computed"`
+	WorkspaceSid           string               `json:"workspace_sid" terraform:"workspace_sid"`
+	FriendlyName           *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	TargetWorkers          *string              `json:"target_workers" terraform:"target_workers" form:"TargetWorkers"`
+	TaskOrder              *string              `json:"task_order" terraform:"task_order,optional,computed,oneof=FIFO|LIFO" form:"TaskOrder"`
+	MaxReservedWorkers     *int                 `json:"max_reserved_workers" terraform:"max_reserved_workers,optional,computed,min=1,max=50" form:"MaxReservedWorkers"`
+	AssignmentActivitySid  *string              `json:"assignment_activity_sid" terraform:"assignment_activity_sid,optional,computed" form:"AssignmentActivitySid"`
+	ReservationActivitySid *string              `json:"reservation_activity_sid" terraform:"reservation_activity_sid,optional,computed" form:"ReservationActivitySid"`
+	DateCreated            twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated            twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                    string               `json:"url" terraform:"url,computed"`
 }
 
-func flattentaskQueueForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
-
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-	v.Add("TargetWorkers", d.Get("target_workers").(string))
-	
-	return v
-}
-
-func resourceTwilioTaskQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioTaskQueueCreate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	workspaceSid := d.Get("workspace_sid").(string)
-	createParams := flattentaskQueueForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Queues.Create")
-
-	taskQueue, err := client.TaskRouter.Workspace(workspaceSid).Queues.Create(context, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Queues.Create failed")
-
-		return err
-	}
-	d.SetId(taskQueue.Sid)
-	d.Set("friendly_name", taskQueue.FriendlyName)
-	d.Set("date_created", taskQueue.DateCreated)
-	d.Set("date_updated", taskQueue.DateUpdated)
-	d.Set("target_workers", taskQueue.TargetWorkers)
-	d.Set("task_order", taskQueue.TaskOrder)
-	d.Set("url", taskQueue.URL)
-	return nil
-}
-
-func resourceTwilioTaskQueueRead(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioTaskQueueRead")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Queues.Get")
-
-	taskQueue, err := client.TaskRouter.Workspace(workspaceSid).Queues.Get(context, sid)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"parent_account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Queues.Get failed")
-
-		return err
-	}
-	d.SetId(taskQueue.Sid)
-	d.Set("friendly_name", taskQueue.FriendlyName)
-	d.Set("date_created", taskQueue.DateCreated)
-	d.Set("date_updated", taskQueue.DateUpdated)
-	d.Set("target_workers", taskQueue.TargetWorkers)
-	d.Set("task_order", taskQueue.TaskOrder)
-	d.Set("url", taskQueue.URL)
-	return nil
-}
-
-func resourceTwilioTaskQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioTaskQueueUpdate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-	createParams := flattentaskQueueForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Queues.Update")
-
-	taskQueue, err := client.TaskRouter.Workspace(workspaceSid).Queues.Update(context, sid, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Queues.Update failed")
-
-		return err
-	}
-	d.SetId(taskQueue.Sid)
-	d.Set("friendly_name", taskQueue.FriendlyName)
-	d.Set("date_created", taskQueue.DateCreated)
-	d.Set("date_updated", taskQueue.DateUpdated)
-	d.Set("target_workers", taskQueue.TargetWorkers)
-	d.Set("task_order", taskQueue.TaskOrder)
-	d.Set("url", taskQueue.URL)
-	return nil
-}
-
-func resourceTwilioTaskQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioTaskQueueDelete")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+func resourceTwilioTaskQueue() *schema.Resource {
+	r := &twilioResource{
+		name:        "TaskRouter.Workspace.TaskQueues",
+		description: "task queue",
+		client: func(c *TerraformTwilioContext) *twiclient.Client {
+			return c.client.TaskRouter
 		},
-	).Debug("START client.TaskRouter.Workspace.Queues.Delete")
-
-	err := client.TaskRouter.Workspace(workspaceSid).Queues.Delete(context, sid)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+		pathPart: "Workspaces/{workspace_sid}/TaskQueues",
+		model: func() interface{} {
+			return new(taskRouterTaskQueue)
 		},
-	).Debug("END client.TaskRouter.Workspace.Queues.Delete")
-	if err != nil {
-		return fmt.Errorf("Failed to delete taskQueue: %s", err.Error())
 	}
-	return nil
+	return r.build()
 }
@@ -0,0 +1,32 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// verifyMessagingConfiguration sends a Verify Service's SMS to a country through a Messaging Service, and is
+// identified by the country. For more documentation, see
+// https://www.twilio.com/docs/verify/api/verify-messaging-configuration
+type verifyMessagingConfiguration struct {
+	ServiceSid          string               `json:"service_sid" terraform:"service_sid"`
+	Country             string               `json:"country" terraform:"country,required,forcenew" form:"Country,omitempty"`
+	MessagingServiceSid string               `json:"messaging_service_sid" terraform:"messaging_service_sid,required" form:"MessagingServiceSid,omitempty"`
+	DateCreated         twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated         twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                 string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioVerifyMessagingConfiguration() *schema.Resource {
+	r := &twilioResource{
+		name:        "Verify.Service.MessagingConfigurations",
+		description: "verify messaging configuration",
+		client:      verifyClient,
+		pathPart:    "Services/{service_sid}/MessagingConfigurations",
+		idAttribute: "country",
+		model: func() interface{} {
+			return new(verifyMessagingConfiguration)
+		},
+	}
+	return r.build()
+}
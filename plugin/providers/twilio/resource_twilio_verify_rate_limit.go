@@ -0,0 +1,57 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// verifyRateLimit names a value (e.g. an end user's IP address) verifications are rate limited by, which is passed
+// along with each verification. For more documentation, see https://www.twilio.com/docs/verify/api/service-rate-limits
+type verifyRateLimit struct {
+	Sid         string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid  string               `json:"service_sid" terraform:"service_sid"`
+	UniqueName  string               `json:"unique_name" terraform:"unique_name,required,forcenew" form:"UniqueName,omitempty"`
+	Description *string              `json:"description" terraform:"description" form:"Description"`
+	DateCreated twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL         string               `json:"url" terraform:"url,computed"`
+}
+
+// verifyRateLimitBucket allows at most `max` verifications per `interval` seconds for each value of its rate limit.
+// For more documentation, see https://www.twilio.com/docs/verify/api/service-rate-limit-buckets
+type verifyRateLimitBucket struct {
+	Sid          string               `json:"sid" terraform:"sid,computed"`
+	ServiceSid   string               `json:"service_sid" terraform:"service_sid"`
+	RateLimitSid string               `json:"rate_limit_sid" terraform:"rate_limit_sid"`
+	Max          int                  `json:"max" terraform:"max,required,min=1" form:"Max,omitempty"`
+	Interval     int                  `json:"interval" terraform:"interval,required,min=1" form:"Interval,omitempty"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string               `json:"url" terraform:"url,computed"`
+}
+
+func resourceTwilioVerifyRateLimit() *schema.Resource {
+	r := &twilioResource{
+		name:        "Verify.Service.RateLimits",
+		description: "verify rate limit",
+		client:      verifyClient,
+		pathPart:    "Services/{service_sid}/RateLimits",
+		model: func() interface{} {
+			return new(verifyRateLimit)
+		},
+	}
+	return r.build()
+}
+
+func resourceTwilioVerifyRateLimitBucket() *schema.Resource {
+	r := &twilioResource{
+		name:        "Verify.Service.RateLimit.Buckets",
+		description: "verify rate limit bucket",
+		client:      verifyClient,
+		pathPart:    "Services/{service_sid}/RateLimits/{rate_limit_sid}/Buckets",
+		model: func() interface{} {
+			return new(verifyRateLimitBucket)
+		},
+	}
+	return r.build()
+}
@@ -0,0 +1,43 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	twiclient "github.com/kaiquelupo/twilio-go"
+)
+
+// verifyService is a Verify Service, the set of settings verifications are sent with. For more documentation, see
+// https://www.twilio.com/docs/verify/api/service
+type verifyService struct {
+	Sid                      string               `json:"sid" terraform:"sid,computed"`
+	AccountSid               string               `json:"account_sid" terraform:"account_sid,computed"`
+	FriendlyName             string               `json:"friendly_name" terraform:"friendly_name,required" form:"FriendlyName,omitempty"`
+	CodeLength               *int                 `json:"code_length" terraform:"code_length,optional,computed,min=4,max=10" form:"CodeLength"`
+	LookupEnabled            *bool                `json:"lookup_enabled" terraform:"lookup_enabled,optional,computed" form:"LookupEnabled"`
+	SkipSmsToLandlines       *bool                `json:"skip_sms_to_landlines" terraform:"skip_sms_to_landlines,optional,computed" form:"SkipSmsToLandlines"`
+	DtmfInputRequired        *bool                `json:"dtmf_input_required" terraform:"dtmf_input_required,optional,computed" form:"DtmfInputRequired"`
+	TtsName                  *string              `json:"tts_name" terraform:"tts_name" form:"TtsName"`
+	Psd2Enabled              *bool                `json:"psd2_enabled" terraform:"psd2_enabled,optional,computed" form:"Psd2Enabled"`
+	DoNotShareWarningEnabled *bool                `json:"do_not_share_warning_enabled" terraform:"do_not_share_warning_enabled,optional,computed" form:"DoNotShareWarningEnabled"`
+	CustomCodeEnabled        *bool                `json:"custom_code_enabled" terraform:"custom_code_enabled,optional,computed" form:"CustomCodeEnabled"`
+	DefaultTemplateSid       *string              `json:"default_template_sid" terraform:"default_template_sid,optional,computed" form:"DefaultTemplateSid"`
+	DateCreated              twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated              twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                      string               `json:"url" terraform:"url,computed"`
+}
+
+func verifyClient(c *TerraformTwilioContext) *twiclient.Client {
+	return c.verify
+}
+
+func resourceTwilioVerifyService() *schema.Resource {
+	r := &twilioResource{
+		name:        "Verify.Services",
+		description: "verify service",
+		client:      verifyClient,
+		pathPart:    "Services",
+		model: func() interface{} {
+			return new(verifyService)
+		},
+	}
+	return r.build()
+}
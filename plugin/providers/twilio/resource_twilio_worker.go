@@ -1,176 +1,36 @@
 package twilio
 
 import (
-	"context"
-	"fmt"
-	"net/url"
-
 	"github.com/hashicorp/terraform/helper/schema"
-	log "github.com/sirupsen/logrus"
+	twiclient "github.com/kaiquelupo/twilio-go"
 )
 
-func resourceTwilioWorker() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTwilioWorkerCreate,
-		Read:   resourceTwilioWorkerRead,
-		Update: resourceTwilioWorkerUpdate,
-		Delete: resourceTwilioWorkerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: map[string]*schema.Schema{
-			"sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"workspace_sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"friendly_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"attributes": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-		},
-	}
+// taskRouterWorker is a TaskRouter Worker. For more documentation, see
+// https://www.twilio.com/docs/taskrouter/api/worker
+type taskRouterWorker struct {
+	Sid          string               `json:"sid" terraform:"sid,computed"`
+	WorkspaceSid string               `json:"workspace_sid" terraform:"workspace_sid"`
+	FriendlyName *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	Attributes   *string              `json:"attributes" terraform:"attributes,json" form:"Attributes"`
+	ActivitySid  *string              `json:"activity_sid" terraform:"activity_sid,optional,computed" form:"ActivitySid"`
+	ActivityName string               `json:"activity_name" terraform:"activity_name,computed"`
+	Available    bool                 `json:"available" terraform:"available,computed"`
+	DateCreated  twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated  twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL          string               `json:"url" terraform:"url,computed"`
 }
 
-func flattenWorkerForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
-
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-	v.Add("Attributes", d.Get("attributes").(string))
-
-	return v
-}
-
-func resourceTwilioWorkerCreate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkerCreate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	workspaceSid := d.Get("workspace_sid").(string)
-	createParams := flattenWorkerForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Workers.Create")
-
-	worker, err := client.TaskRouter.Workspace(workspaceSid).Workers.Create(context, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workers.Create failed")
-
-		return err
-	}
-	d.SetId(worker.Sid)
-	d.Set("friendly_name", worker.FriendlyName)
-	d.Set("date_created", worker.DateCreated)
-	d.Set("date_updated", worker.DateUpdated)
-	return nil
-}
-
-func resourceTwilioWorkerRead(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkerRead")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Workers.Get")
-
-	worker, err := client.TaskRouter.Workspace(workspaceSid).Workers.Get(context, sid)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"parent_account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workers.Get failed")
-
-		return err
-	}
-	d.Set("friendly_name", worker.FriendlyName)
-	d.Set("attributes", worker.Attributes)
-	d.Set("date_created", worker.DateCreated)
-	d.Set("date_updated", worker.DateUpdated)
-	return nil
-}
-
-func resourceTwilioWorkerUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkerUpdate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-	updateParams := flattenWorkerForCreate(d)
-
-	worker, err := client.TaskRouter.Workspace(workspaceSid).Workers.Update(context, sid, updateParams)
-
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workers.Update failed")
-
-		return err
-	}
-	d.SetId(worker.Sid)
-	d.Set("friendly_name", worker.FriendlyName)
-	d.Set("date_created", worker.DateCreated)
-	d.Set("date_updated", worker.DateUpdated)
-	
-	return nil
-}
-
-func resourceTwilioWorkerDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkerDelete")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+func resourceTwilioWorker() *schema.Resource {
+	r := &twilioResource{
+		name:        "TaskRouter.Workspace.Workers",
+		description: "worker",
+		client: func(c *TerraformTwilioContext) *twiclient.Client {
+			return c.client.TaskRouter
 		},
-	).Debug("START client.TaskRouter.Workspace.Workers.Delete")
-
-	err := client.TaskRouter.Workspace(workspaceSid).Workers.Delete(context, sid)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+		pathPart: "Workspaces/{workspace_sid}/Workers",
+		model: func() interface{} {
+			return new(taskRouterWorker)
 		},
-	).Debug("END client.TaskRouter.Workspace.Workers.Delete")
-	if err != nil {
-		return fmt.Errorf("Failed to delete worker: %s", err.Error())
 	}
-	return nil
+	return r.build()
 }
@@ -1,187 +1,36 @@
 package twilio
 
 import (
-	"context"
-	"fmt"
-	"net/url"
-
 	"github.com/hashicorp/terraform/helper/schema"
-	log "github.com/sirupsen/logrus"
+	twiclient "github.com/kaiquelupo/twilio-go"
 )
 
-func resourceTwilioWorkflow() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTwilioWorkflowCreate,
-		Read:   resourceTwilioWorkflowRead,
-		Update: resourceTwilioWorkflowUpdate,
-		Delete: resourceTwilioWorkflowDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: map[string]*schema.Schema{
-			"sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"friendly_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"workspace_sid": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"configuration": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"assignment_callback_url": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-		},
-	}
-}
-
-func flattenWorkflowForCreate(d *schema.ResourceData) url.Values {
-	v := make(url.Values)
-
-	v.Add("FriendlyName", d.Get("friendly_name").(string))
-	v.Add("Configuration", d.Get("configuration").(string))
-	v.Add("AssignmentCallbackUrl", d.Get("assignment_callback_url").(string))
-
-	return v
-}
-
-func resourceTwilioWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkflowCreate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	workspaceSid := d.Get("workspace_sid").(string)
-	createParams := flattenWorkflowForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Workflows.Create")
-
-	workflow, err := client.TaskRouter.Workspace(workspaceSid).Workflows.Create(context, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workflows.Create failed")
-
-		return err
-	}
-	d.SetId(workflow.Sid)
-	d.Set("friendly_name", workflow.FriendlyName)
-	d.Set("date_created", workflow.DateCreated)
-	d.Set("date_updated", workflow.DateUpdated)
-	d.Set("task_reservation_timeout", workflow.TaskReservationTimeout)
-	return nil
-}
-
-func resourceTwilioWorkflowRead(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkflowRead")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Workflows.Get")
-
-	workflow, err := client.TaskRouter.Workspace(workspaceSid).Workflows.Get(context, sid)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"parent_account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workflows.Get failed")
-
-		return err
-	}
-	d.Set("friendly_name", workflow.FriendlyName)
-	d.Set("date_created", workflow.DateCreated)
-	d.Set("date_updated", workflow.DateUpdated)
-	d.Set("task_reservation_timeout", workflow.TaskReservationTimeout)
-	return nil
+// taskRouterWorkflow is a TaskRouter Workflow. For more documentation, see
+// https://www.twilio.com/docs/taskrouter/api/workflow
+type taskRouterWorkflow struct {
+	Sid                           string               `json:"sid" terraform:"sid,computed"`
+	WorkspaceSid                  string               `json:"workspace_sid" terraform:"workspace_sid"`
+	FriendlyName                  *string              `json:"friendly_name" terraform:"friendly_name" form:"FriendlyName"`
+	Configuration                 string               `json:"configuration" terraform:"configuration,required,json" form:"Configuration,omitempty"`
+	AssignmentCallbackURL         *string              `json:"assignment_callback_url" terraform:"assignment_callback_url,validate=url" form:"AssignmentCallbackUrl"`
+	FallbackAssignmentCallbackURL *string              `json:"fallback_assignment_callback_url" terraform:"fallback_assignment_callback_url,validate=url" form:"FallbackAssignmentCallbackUrl"`
+	TaskReservationTimeout        *int                 `json:"task_reservation_timeout" terraform:"task_reservation_timeout,optional,computed" form:"TaskReservationTimeout"`
+	DateCreated                   twiclient.TwilioTime `json:"date_created" terraform:"date_created,computed"`
+	DateUpdated                   twiclient.TwilioTime `json:"date_updated" terraform:"date_updated,computed"`
+	URL                           string               `json:"url" terraform:"url,computed"`
 }
 
-func resourceTwilioWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkflowUpdate")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-	createParams := flattenWorkflowForCreate(d)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-		},
-	).Debug("START client.TaskRouter.Workspace.Workflows.Update")
-
-	workflow, err := client.TaskRouter.Workspace(workspaceSid).Workflows.Update(context, sid, createParams)
-	if err != nil {
-		log.WithFields(
-			log.Fields{
-				"account_sid": config.AccountSID,
-			},
-		).WithError(err).Error("client.TaskRouter.Workspace.Workflows.Update failed")
-
-		return err
-	}
-	d.SetId(workflow.Sid)
-	d.Set("friendly_name", workflow.FriendlyName)
-	d.Set("date_created", workflow.DateCreated)
-	d.Set("date_updated", workflow.DateUpdated)
-	d.Set("task_reservation_timeout", workflow.TaskReservationTimeout)
-	return nil
-}
-
-func resourceTwilioWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioWorkflowDelete")
-
-	client := meta.(*TerraformTwilioContext).client
-	config := meta.(*TerraformTwilioContext).configuration
-	context := context.TODO()
-
-	sid := d.Id()
-	workspaceSid := d.Get("workspace_sid").(string)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+func resourceTwilioWorkflow() *schema.Resource {
+	r := &twilioResource{
+		name:        "TaskRouter.Workspace.Workflows",
+		description: "workflow",
+		client: func(c *TerraformTwilioContext) *twiclient.Client {
+			return c.client.TaskRouter
 		},
-	).Debug("START client.TaskRouter.Workspace.Workflows.Delete")
-
-	err := client.TaskRouter.Workspace(workspaceSid).Workflows.Delete(context, sid)
-
-	log.WithFields(
-		log.Fields{
-			"account_sid": config.AccountSID,
-			"queue_sid":   sid,
+		pathPart: "Workspaces/{workspace_sid}/Workflows",
+		model: func() interface{} {
+			return new(taskRouterWorkflow)
 		},
-	).Debug("END client.TaskRouter.Workspace.Workflows.Delete")
-	if err != nil {
-		return fmt.Errorf("Failed to delete workflow: %s", err.Error())
 	}
-	return nil
+	return r.build()
 }
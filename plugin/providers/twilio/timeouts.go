@@ -0,0 +1,42 @@
+package twilio
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// defaultOperationTimeout bounds a single create, read, update or delete, including any retries. Slow operations can
+// be given longer with a `timeouts` block on the resource.
+const defaultOperationTimeout = 5 * time.Minute
+
+// defaultResourceTimeouts enables the `timeouts` block on a resource, with every operation defaulting to
+// defaultOperationTimeout.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return resourceTimeouts(nil)
+}
+
+// resourceTimeouts enables the `timeouts` block on a resource whose slow operations need a longer default, keyed by
+// schema.TimeoutCreate and the like. Other operations default to defaultOperationTimeout.
+func resourceTimeouts(overrides map[string]time.Duration) *schema.ResourceTimeout {
+	timeout := func(key string) *time.Duration {
+		if override, ok := overrides[key]; ok {
+			return schema.DefaultTimeout(override)
+		}
+		return schema.DefaultTimeout(defaultOperationTimeout)
+	}
+
+	return &schema.ResourceTimeout{
+		Create: timeout(schema.TimeoutCreate),
+		Read:   timeout(schema.TimeoutRead),
+		Update: timeout(schema.TimeoutUpdate),
+		Delete: timeout(schema.TimeoutDelete),
+	}
+}
+
+// operationContext returns the context for a resource operation. It is cancelled when the operation's timeout
+// elapses or when Terraform is interrupted.
+func (c *TerraformTwilioContext) operationContext(d *schema.ResourceData, timeoutKey string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.stopContext, d.Timeout(timeoutKey))
+}
@@ -0,0 +1,164 @@
+package twilio
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMaxRetries = 5
+	minRetryBackoff   = 1 * time.Second
+	maxRetryBackoff   = 30 * time.Second
+	// attemptTimeout bounds each attempt the way twilio-go's default client bounds a whole request.
+	attemptTimeout = 30*time.Second + 500*time.Millisecond
+)
+
+// retryTransport retries requests Twilio rejected because of its concurrency limit (429, error 20429), as well as
+// idempotent requests that failed with a 5xx or a network error, using jittered exponential backoff. A 429 means the
+// request wasn't processed, so it is safe to retry whatever the method.
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+}
+
+func newRetryTransport(transport http.RoundTripper, maxRetries int) *retryTransport {
+	return &retryTransport{
+		transport:  transport,
+		maxRetries: maxRetries,
+	}
+}
+
+// newHTTPClient returns the HTTP client shared by every Twilio client the provider builds. It has no overall timeout,
+// as that would include the time spent backing off; each attempt is bounded by attemptTimeout instead. Retries go
+// through the rate limiter like any other request, and each attempt is traced separately.
+func newHTTPClient(config *Config) *http.Client {
+	transport := newLoggingTransport(http.DefaultTransport, config.LogHTTPTraffic)
+	transport = newRateLimitTransport(transport, config.RequestsPerSecond, config.ProductRequestsPerSecond)
+
+	return &http.Client{
+		Transport: newRetryTransport(transport, config.MaxRetries),
+	}
+}
+
+// cancelOnClose releases an attempt's context once the response body has been read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// RoundTrip sends each attempt as a clone of req, as a RoundTripper must not modify the caller's request. Retries
+// replay the body from GetBody.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(req.Context(), attemptTimeout)
+		attemptReq := req.Clone(ctx)
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			if err != nil {
+				cancel()
+				return resp, err
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := retryBackoff(attempt, resp)
+
+		log.WithFields(
+			log.Fields{
+				"method":  req.Method,
+				"path":    req.URL.Path,
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			},
+		).Warn("Retrying Twilio request")
+
+		if resp != nil {
+			// Drain the body so the connection can be reused.
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		cancel()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// A request with a body that can't be replayed can't be retried.
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method) && req.Context().Err() == nil
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode >= 500 && isIdempotent(req.Method)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryBackoff honors Retry-After when Twilio sends it and otherwise backs off exponentially from minRetryBackoff,
+// picking a random wait in the upper half of the window so that parallel resource operations spread out.
+func retryBackoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter := parseRetryAfter(resp.Header.Get("Retry-After")); retryAfter > 0 {
+			return retryAfter
+		}
+	}
+
+	backoff := minRetryBackoff << uint(attempt)
+	if backoff <= 0 || backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
@@ -0,0 +1,142 @@
+package twilio
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// roundTripFunc lets a function stand in for the transport a retryTransport wraps.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func transportTestResponse(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}
+}
+
+var _ = Describe("retryTransport", func() {
+	table.DescribeTable("shouldRetry",
+		func(method string, status int, err error, expected bool) {
+			req, _ := http.NewRequest(method, "https://api.twilio.com/2010-04-01/Accounts.json", nil)
+
+			var resp *http.Response
+			if err == nil {
+				resp = transportTestResponse(status, nil)
+			}
+			Expect(shouldRetry(req, resp, err)).To(Equal(expected))
+		},
+		table.Entry("GET on 429", http.MethodGet, http.StatusTooManyRequests, nil, true),
+		table.Entry("POST on 429", http.MethodPost, http.StatusTooManyRequests, nil, true),
+		table.Entry("GET on 503", http.MethodGet, http.StatusServiceUnavailable, nil, true),
+		table.Entry("DELETE on 500", http.MethodDelete, http.StatusInternalServerError, nil, true),
+		table.Entry("POST on 500", http.MethodPost, http.StatusInternalServerError, nil, false),
+		table.Entry("GET on 404", http.MethodGet, http.StatusNotFound, nil, false),
+		table.Entry("GET on 200", http.MethodGet, http.StatusOK, nil, false),
+		table.Entry("GET on a network error", http.MethodGet, 0, errors.New("connection reset"), true),
+		table.Entry("POST on a network error", http.MethodPost, 0, errors.New("connection reset"), false),
+	)
+
+	It("doesn't retry a body it can't replay", func() {
+		req, _ := http.NewRequest(http.MethodPost, "https://api.twilio.com/2010-04-01/Accounts.json", strings.NewReader("A=1"))
+		req.GetBody = nil
+
+		Expect(shouldRetry(req, transportTestResponse(http.StatusTooManyRequests, nil), nil)).To(BeFalse())
+	})
+
+	table.DescribeTable("parseRetryAfter",
+		func(value string, expected time.Duration) {
+			Expect(parseRetryAfter(value)).To(Equal(expected))
+		},
+		table.Entry("missing", "", time.Duration(0)),
+		table.Entry("seconds", "3", 3*time.Second),
+		table.Entry("invalid", "soon", time.Duration(0)),
+	)
+
+	It("parses Retry-After given as an HTTP date", func() {
+		date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+
+		Expect(parseRetryAfter(date)).To(BeNumerically("~", 10*time.Second, 1500*time.Millisecond))
+	})
+
+	It("honors Retry-After", func() {
+		resp := transportTestResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"7"}})
+
+		Expect(retryBackoff(0, resp)).To(Equal(7 * time.Second))
+	})
+
+	It("backs off exponentially within the upper half of the window, up to maxRetryBackoff", func() {
+		for attempt := 0; attempt < 70; attempt++ {
+			window := minRetryBackoff << uint(attempt)
+			if attempt >= 5 {
+				window = maxRetryBackoff
+			}
+
+			for i := 0; i < 20; i++ {
+				backoff := retryBackoff(attempt, nil)
+				Expect(backoff).To(BeNumerically(">=", window/2), "attempt %d", attempt)
+				Expect(backoff).To(BeNumerically("<=", window), "attempt %d", attempt)
+			}
+		}
+	})
+
+	It("replays the body on each attempt without modifying the caller's request", func() {
+		var bodies []string
+		var attempts []*http.Request
+		transport := newRetryTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(body))
+			attempts = append(attempts, req)
+
+			if len(attempts) == 1 {
+				return transportTestResponse(http.StatusTooManyRequests, nil), nil
+			}
+			return transportTestResponse(http.StatusCreated, nil), nil
+		}), 1)
+
+		req, _ := http.NewRequest(http.MethodPost, "https://api.twilio.com/2010-04-01/Accounts.json", strings.NewReader("FriendlyName=test"))
+		body := req.Body
+
+		resp, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+		Expect(bodies).To(Equal([]string{"FriendlyName=test", "FriendlyName=test"}))
+		Expect(attempts[0]).NotTo(BeIdenticalTo(req))
+		Expect(attempts[1]).NotTo(BeIdenticalTo(req))
+		Expect(req.Body).To(BeIdenticalTo(body))
+	})
+
+	It("gives up after maxRetries", func() {
+		attempts := 0
+		transport := newRetryTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return transportTestResponse(http.StatusTooManyRequests, nil), nil
+		}), 0)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://api.twilio.com/2010-04-01/Accounts.json", nil)
+
+		resp, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+		Expect(attempts).To(Equal(1))
+	})
+})
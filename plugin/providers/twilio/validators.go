@@ -0,0 +1,52 @@
+package twilio
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// validateHTTPMethod accepts the HTTP methods Twilio will use when requesting a callback URL.
+var validateHTTPMethod = validation.StringInSlice([]string{"GET", "POST"}, false)
+
+// validateURL ensures that a value is an absolute http or https URL. Empty values are allowed so that optional
+// callback attributes can be cleared.
+func validateURL(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid URL: %s", k, err))
+		return
+	}
+
+	if u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		errors = append(errors, fmt.Errorf("%s must be an absolute http or https URL, got: %s", k, value))
+	}
+
+	return
+}
+
+// validateNonNegativeFloat ensures that a float attribute is zero or greater.
+func validateNonNegativeFloat(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(float64)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be float", k))
+		return
+	}
+
+	if value < 0 {
+		errors = append(errors, fmt.Errorf("expected %s to be at least 0, got %v", k, value))
+	}
+
+	return
+}